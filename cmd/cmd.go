@@ -2,6 +2,7 @@ package main
 
 import (
 	"go-enterprise-blueprint/internal/app"
+	"os"
 
 	"github.com/rise-and-shine/pkg/observability/logger"
 	"github.com/spf13/cobra"
@@ -15,8 +16,11 @@ func main() {
 	root.AddCommand(app.AuthCommands())
 	// Add new modules CLI commands here...
 
-	// ignoring error since it's already displayed by cobra.
-	_ = root.Execute()
+	// error is already displayed by cobra, only the exit code is left to be reported.
+	err := root.Execute()
+	if err != nil {
+		os.Exit(1)
+	}
 }
 
 // run registers a main command that runs all services.
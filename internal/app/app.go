@@ -2,6 +2,7 @@ package app
 
 import (
 	"go-enterprise-blueprint/internal/modules/auth"
+	"go-enterprise-blueprint/internal/portal"
 
 	"github.com/rise-and-shine/pkg/cfgloader"
 	"github.com/rise-and-shine/pkg/http/server"
@@ -44,12 +45,15 @@ type app struct {
 
 	httpServer *server.HTTPServer
 
+	portalContainer *portal.Container
+
 	auth *auth.Module
 }
 
 func newApp() *app {
 	app := &app{
-		cfg: cfgloader.MustLoad[Config](),
+		cfg:             cfgloader.MustLoad[Config](),
+		portalContainer: &portal.Container{},
 	}
 	return app
 }
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"go-enterprise-blueprint/internal/modules/auth"
+
 	"github.com/code19m/errx"
 	"github.com/spf13/cobra"
 )
@@ -12,15 +14,26 @@ func AuthCommands() *cobra.Command {
 	}
 
 	cmd.AddCommand(createSuperAdminCmd())
+	cmd.AddCommand(resetAdminPasswordCmd())
 	// Add auth modules new CLI commands here...
 
 	return cmd
 }
 
 func createSuperAdminCmd() *cobra.Command {
-	return &cobra.Command{
+	var opts auth.CreateSuperadminOptions
+
+	cmd := &cobra.Command{
 		Use:   "create-superadmin",
 		Short: "Create superadmin account for system bootstrap",
+		Long: "Creates superadmin account. Username and password are taken from flags, " +
+			"AUTH_SUPERADMIN_USERNAME and AUTH_SUPERADMIN_PASSWORD environment variables, " +
+			"or asked interactively if stdin is a terminal.",
+		Example: `  # Interactive
+  app auth create-superadmin
+
+  # Kubernetes init job or CI
+  echo "$PASSWORD" | app auth create-superadmin --username root --password-stdin --if-not-exists --json`,
 		RunE: func(_ *cobra.Command, _ []string) error {
 			app := newApp()
 			defer app.shutdownInfraComponents()
@@ -30,9 +43,49 @@ func createSuperAdminCmd() *cobra.Command {
 				return errx.Wrap(err)
 			}
 
-			return app.auth.CreateSuperadmin()
+			return app.auth.CreateSuperadmin(opts)
 		},
 	}
+
+	cmd.Flags().StringVar(&opts.Username, "username", "", "username of the superadmin")
+	cmd.Flags().BoolVar(&opts.PasswordStdin, "password-stdin", false, "read the password from stdin")
+	cmd.Flags().StringVar(&opts.PasswordFile, "password-file", "", "read the password from the file")
+	cmd.Flags().BoolVar(&opts.IfNotExists, "if-not-exists", false, "exit successfully if the username already exists")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "print a machine-readable JSON result")
+	cmd.MarkFlagsMutuallyExclusive("password-stdin", "password-file")
+
+	return cmd
+}
+
+func resetAdminPasswordCmd() *cobra.Command {
+	var (
+		username string
+		resetMFA bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reset-admin-password",
+		Short: "Set a new password of an admin for break-glass recovery",
+		Long: "Sets a new password of an admin without the current password, revokes all of its sessions " +
+			"and clears its login lockout. Use it when the only superadmin can't log in anymore.",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			app := newApp()
+			defer app.shutdownInfraComponents()
+
+			err := app.init()
+			if err != nil {
+				return errx.Wrap(err)
+			}
+
+			return app.auth.ResetAdminPassword(username, resetMFA)
+		},
+	}
+
+	cmd.Flags().StringVar(&username, "username", "", "username of the admin")
+	cmd.Flags().BoolVar(&resetMFA, "reset-mfa", false, "also disable two-factor authentication of the admin")
+	_ = cmd.MarkFlagRequired("username")
+
+	return cmd
 }
 
 // Add your new CLI commands here...
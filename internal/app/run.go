@@ -1,8 +1,9 @@
 package app
 
 import (
+	"context"
 	"go-enterprise-blueprint/internal/modules/auth"
-	"go-enterprise-blueprint/internal/portal"
+	authportal "go-enterprise-blueprint/internal/portal/auth"
 	"go-enterprise-blueprint/pkg/baseserver"
 	"os"
 	"os/signal"
@@ -106,7 +107,7 @@ func (a *app) initSharedComponents() error {
 	}
 
 	// init http server
-	a.httpServer = baseserver.New(a.cfg.HTTPServer)
+	a.httpServer = baseserver.New(a.cfg.HTTPServer, a.authenticate, a.authenticateAPIKey)
 
 	return nil
 }
@@ -116,11 +117,9 @@ func (a *app) initModules() error {
 		err error
 	)
 
-	portalContainer := &portal.Container{}
-
 	// Init all your modules here...
 	a.auth, err = auth.New(
-		a.cfg.Auth, a.cfg.KafkaBroker, a.dbConn, portalContainer, a.httpServer,
+		a.cfg.Auth, a.cfg.KafkaBroker, a.dbConn, a.portalContainer, a.httpServer,
 	)
 	if err != nil {
 		return errx.Wrap(err)
@@ -133,10 +132,33 @@ func (a *app) initModules() error {
 	// Platform
 
 	// Set all portal implementations here...
-	portalContainer.SetAuthPortal(a.auth.Portal())
-	// portalContainer.SetAuditPortal(audit.Portal())
-	// portalContainer.SetEsignPortal(esign.Portal())
-	// portalContainer.SetPlatformPortal(platform.Portal())
+	a.portalContainer.SetAuthPortal(a.auth.Portal())
+	// a.portalContainer.SetAuditPortal(audit.Portal())
+	// a.portalContainer.SetEsignPortal(esign.Portal())
+	// a.portalContainer.SetPlatformPortal(platform.Portal())
+
+	// Register permission catalogues of modules here...
+	// (auth module registers its own permissions on initialization)
+	// err = a.portalContainer.Auth().RegisterPermissions("esign", esignportal.Permissions())
 
 	return nil
 }
+
+// authenticate resolves the calling actor of HTTP requests through the auth portal.
+// The portal is looked up lazily, since HTTP server is created before modules are initialized.
+func (a *app) authenticate(ctx context.Context, token string) (context.Context, error) {
+	actor, err := a.portalContainer.Auth().Authenticate(ctx, token)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return authportal.WithActor(ctx, actor), nil
+}
+
+// authenticateAPIKey resolves the calling service account of HTTP requests through the auth portal.
+func (a *app) authenticateAPIKey(ctx context.Context, apiKey string) (context.Context, error) {
+	actor, err := a.portalContainer.Auth().AuthenticateAPIKey(ctx, apiKey)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return authportal.WithActor(ctx, actor), nil
+}
@@ -15,13 +15,27 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// Config holds the configs of async tasks this controller is responsible for.
+type Config struct {
+	// SessionCleanupCron is a cron pattern of the expired sessions cleanup. Default is every 15 minutes.
+	SessionCleanupCron string `yaml:"session_cleanup_cron" validate:"required" default:"*/15 * * * *"`
+
+	// GrantCleanupCron is a cron pattern of the expired grants cleanup. Default is every 5 minutes.
+	GrantCleanupCron string `yaml:"grant_cleanup_cron" validate:"required" default:"*/5 * * * *"`
+
+	// LoginFailureCleanupCron is a cron pattern of the stale login failures cleanup. Default is every hour.
+	LoginFailureCleanupCron string `yaml:"login_failure_cleanup_cron" validate:"required" default:"0 * * * *"`
+}
+
 type Controller struct {
+	cfg              Config
 	worker           worker.Worker
 	scheduler        scheduler.Scheduler
 	usecaseContainer *usecase.Container
 }
 
 func NewController(
+	cfg Config,
 	dbConn *bun.DB,
 	queueName string,
 	usecaseContainer *usecase.Container,
@@ -37,6 +51,7 @@ func NewController(
 	}
 
 	ctrl := &Controller{
+		cfg,
 		worker,
 		scheduler,
 		usecaseContainer,
@@ -85,6 +100,10 @@ func (c *Controller) Shutdown() error {
 }
 
 func (c *Controller) registerTasks() {
+	worker.ForwardToAsyncTask(c.worker, c.usecaseContainer.CleanupExpiredSessions())
+	worker.ForwardToAsyncTask(c.worker, c.usecaseContainer.CleanupExpiredGrants())
+	worker.ForwardToAsyncTask(c.worker, c.usecaseContainer.CleanupLoginFailures())
+
 	// Register async tasks here...
 	// worker.ForwardToAsyncTask(c.worker, c.usecaseContainer.SomeAsyncTask())
 }
@@ -99,6 +118,18 @@ func (c *Controller) registerSchedules() error {
 
 	err := c.scheduler.RegisterSchedules(
 		ctx,
+		scheduler.Schedule{
+			CronPattern: c.cfg.SessionCleanupCron,
+			OperationID: c.usecaseContainer.CleanupExpiredSessions().OperationID(),
+		},
+		scheduler.Schedule{
+			CronPattern: c.cfg.GrantCleanupCron,
+			OperationID: c.usecaseContainer.CleanupExpiredGrants().OperationID(),
+		},
+		scheduler.Schedule{
+			CronPattern: c.cfg.LoginFailureCleanupCron,
+			OperationID: c.usecaseContainer.CleanupLoginFailures().OperationID(),
+		},
 		// Register cron schedules here...
 		// scheduler.Schedule{
 		// 	CronPattern: "* * * * *", // every minute
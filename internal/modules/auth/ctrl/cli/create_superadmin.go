@@ -4,8 +4,12 @@ package cli
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/passwordpolicy"
 	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createsuperadmin"
+	"io"
 	"os"
 	"strings"
 	"syscall"
@@ -17,22 +21,102 @@ import (
 	"golang.org/x/term"
 )
 
-func (c *Controller) CreateSuperadminCmd() error {
-	const (
-		executionTimeout = 30 * time.Second
-	)
+// Environment variables read by create-superadmin when the corresponding flags are not given.
+const (
+	EnvSuperadminUsername = "AUTH_SUPERADMIN_USERNAME"
+	EnvSuperadminPassword = "AUTH_SUPERADMIN_PASSWORD"
+)
+
+// Length bounds of usernames, the same as of admins created via API.
+const (
+	minUsernameLen = 3
+	maxUsernameLen = 50
+)
 
-	reader := bufio.NewReader(os.Stdin)
+// Statuses of create-superadmin JSON output.
+const (
+	statusCreated       = "created"
+	statusAlreadyExists = "already_exists"
+	statusError         = "error"
+)
 
-	username, err := askUsername(reader)
+// CreateSuperadminOptions are flags of create-superadmin command.
+// Values which are not given are read from environment variables
+// and asked interactively as the last resort if stdin is a terminal.
+type CreateSuperadminOptions struct {
+	// Username of the superadmin, falls back to AUTH_SUPERADMIN_USERNAME.
+	Username string
+	// PasswordStdin reads the password from stdin, e.g. `echo "$PASSWORD" | app auth create-superadmin --password-stdin`.
+	PasswordStdin bool
+	// PasswordFile reads the password from the file, e.g. a mounted Kubernetes secret.
+	PasswordFile string
+	// IfNotExists exits successfully if an admin with the username already exists.
+	IfNotExists bool
+	// JSON prints a machine-readable result to stdout.
+	JSON bool
+}
+
+type createSuperadminResult struct {
+	Status   string `json:"status"`
+	Username string `json:"username,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Message  string `json:"message,omitempty"`
+	Details  any    `json:"details,omitempty"`
+}
+
+func (c *Controller) CreateSuperadminCmd(opts CreateSuperadminOptions) error {
+	username, err := resolveUsername(opts)
 	if err != nil {
-		return errx.Wrap(err)
+		return printCreateSuperadminError(opts, err)
 	}
 
-	password, err := askPassword()
+	password, err := resolvePassword(opts)
 	if err != nil {
-		return errx.Wrap(err)
+		return printCreateSuperadminError(opts, err)
+	}
+
+	for {
+		err = c.createSuperadmin(&createsuperadmin.Input{
+			Username: username,
+			Password: password,
+		})
+
+		// Ask another password if the operator is at the terminal
+		if errx.IsCodeIn(err, passwordpolicy.CodeWeakPassword) && isInteractive(opts) {
+			printPasswordViolations(err)
+			password, err = askPassword()
+			if err != nil {
+				return errx.Wrap(err)
+			}
+			continue
+		}
+
+		// An existing admin which is disabled or not a superadmin fails the command even with --if-not-exists
+		if errx.IsCodeIn(err, user.CodeAdminUsernameConflict) && opts.IfNotExists {
+			printCreateSuperadminResult(opts, createSuperadminResult{
+				Status:   statusAlreadyExists,
+				Username: username,
+			}, "Superadmin with this username already exists, nothing to do")
+			return nil
+		}
+		if err != nil {
+			return printCreateSuperadminError(opts, err)
+		}
+
+		printCreateSuperadminResult(opts, createSuperadminResult{
+			Status:   statusCreated,
+			Username: username,
+		}, "Superadmin created successfully")
+		return nil
 	}
+}
+
+// createSuperadmin executes the use case with its own timeout,
+// so time spent at the password prompt doesn't count against it.
+func (c *Controller) createSuperadmin(input *createsuperadmin.Input) error {
+	const (
+		executionTimeout = 30 * time.Second
+	)
 
 	// Set timeout
 	ctx, cancel := context.WithTimeout(context.Background(), executionTimeout)
@@ -41,24 +125,100 @@ func (c *Controller) CreateSuperadminCmd() error {
 	// Set trace ID to context
 	ctx = context.WithValue(ctx, meta.TraceID, tracing.GetStartingTraceID(ctx))
 
-	err = c.usecaseContainer.CreateSuperadmin().Execute(ctx, &createsuperadmin.Input{
-		Username: username,
-		Password: password,
-	})
-	if err != nil {
-		return errx.Wrap(err)
+	return errx.Wrap(c.usecaseContainer.CreateSuperadmin().Execute(ctx, input))
+}
+
+func resolveUsername(opts CreateSuperadminOptions) (string, error) {
+	if opts.Username != "" {
+		return opts.Username, validateUsername(opts.Username)
+	}
+	if username := os.Getenv(EnvSuperadminUsername); username != "" {
+		return username, validateUsername(username)
+	}
+	if !isInteractive(opts) {
+		return "", errx.New(
+			"username is not given, use --username flag or " + EnvSuperadminUsername + " environment variable",
+		)
+	}
+	return askUsername(bufio.NewReader(os.Stdin))
+}
+
+func resolvePassword(opts CreateSuperadminOptions) (string, error) {
+	switch {
+	case opts.PasswordStdin && opts.PasswordFile != "":
+		return "", errx.New("--password-stdin and --password-file can't be used together")
+	case opts.PasswordStdin:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", errx.Wrap(err)
+		}
+		return trimPassword(data), nil
+	case opts.PasswordFile != "":
+		data, err := os.ReadFile(opts.PasswordFile)
+		if err != nil {
+			return "", errx.Wrap(err)
+		}
+		return trimPassword(data), nil
+	}
+
+	if password := os.Getenv(EnvSuperadminPassword); password != "" {
+		return password, nil
+	}
+	if !isInteractive(opts) {
+		return "", errx.New(
+			"password is not given, use --password-stdin, --password-file flag or " +
+				EnvSuperadminPassword + " environment variable",
+		)
 	}
+	return askPassword()
+}
+
+// trimPassword removes the trailing line break added by echo and text editors.
+func trimPassword(data []byte) string {
+	return strings.TrimRight(string(data), "\r\n")
+}
+
+// isInteractive reports whether missing inputs can be asked from the operator.
+func isInteractive(opts CreateSuperadminOptions) bool {
+	return !opts.JSON && !opts.PasswordStdin && term.IsTerminal(int(os.Stdin.Fd())) //nolint:gosec // fd fits int
+}
+
+func printCreateSuperadminResult(opts CreateSuperadminOptions, result createSuperadminResult, text string) {
+	if !opts.JSON {
+		fmt.Println(text)
+		return
+	}
+
+	out, _ := json.Marshal(result) //nolint:errchkjson // result is always serializable
+	fmt.Println(string(out))
+}
+
+// printCreateSuperadminError prints the error as JSON in JSON mode and returns it, so the command exits with non-zero code.
+func printCreateSuperadminError(opts CreateSuperadminOptions, err error) error {
+	if opts.JSON {
+		e := errx.AsErrorX(err)
+		printCreateSuperadminResult(opts, createSuperadminResult{
+			Status:  statusError,
+			Code:    e.Code(),
+			Message: e.Error(),
+			Details: e.Details(),
+		}, "")
+	}
+	return errx.Wrap(err)
+}
 
-	fmt.Println("Superadmin created successfully")
+// validateUsername checks the length bounds of the username given by any input source.
+func validateUsername(username string) error {
+	switch {
+	case len(username) < minUsernameLen:
+		return errx.New(fmt.Sprintf("username must be at least %d characters", minUsernameLen))
+	case len(username) > maxUsernameLen:
+		return errx.New(fmt.Sprintf("username must be at most %d characters", maxUsernameLen))
+	}
 	return nil
 }
 
 func askUsername(reader *bufio.Reader) (string, error) {
-	const (
-		minUsernameLen = 3
-		maxUsernameLen = 30
-	)
-
 	for {
 		fmt.Print("\nEnter username: ")
 		input, err := reader.ReadString('\n')
@@ -67,12 +227,9 @@ func askUsername(reader *bufio.Reader) (string, error) {
 		}
 		username := strings.TrimSpace(input)
 
-		if len(username) < minUsernameLen {
-			fmt.Printf("Username must be at least %d characters\n", minUsernameLen)
-			continue
-		}
-		if len(username) > maxUsernameLen {
-			fmt.Printf("Username must be at most %d characters\n", maxUsernameLen)
+		err = validateUsername(username)
+		if err != nil {
+			fmt.Println(err.Error())
 			continue
 		}
 
@@ -81,23 +238,39 @@ func askUsername(reader *bufio.Reader) (string, error) {
 }
 
 func askPassword() (string, error) {
-	const (
-		minPasswordLen = 5
-	)
-
 	for {
 		fmt.Print("\nEnter password: ")
-		passwordBytes, err := term.ReadPassword(syscall.Stdin)
+		password, err := readPassword()
 		if err != nil {
 			return "", errx.Wrap(err)
 		}
-		password := string(passwordBytes)
 
-		if len(password) < minPasswordLen {
-			fmt.Printf("Password must be at least %d characters\n", minPasswordLen)
+		if password == "" {
+			fmt.Println("Password must not be empty")
 			continue
 		}
 
 		return password, nil
 	}
 }
+
+// readPassword reads a password from the terminal without echoing it.
+func readPassword() (string, error) {
+	passwordBytes, err := term.ReadPassword(syscall.Stdin)
+	if err != nil {
+		return "", errx.Wrap(err)
+	}
+	fmt.Println()
+
+	return string(passwordBytes), nil
+}
+
+// printPasswordViolations prints every password policy rule reported by the weak password error.
+func printPasswordViolations(err error) {
+	fmt.Println("Password does not satisfy the password policy:")
+
+	violations, _ := errx.AsErrorX(err).Details()["violations"].([]passwordpolicy.Violation)
+	for _, v := range violations {
+		fmt.Printf("  - %s\n", v.Message)
+	}
+}
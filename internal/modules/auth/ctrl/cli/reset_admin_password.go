@@ -0,0 +1,83 @@
+//nolint:forbidigo // using fmt.Printf is allowed for CLI commands
+package cli
+
+import (
+	"context"
+	"fmt"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/passwordpolicy"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/resetadminpassword"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/meta"
+	"github.com/rise-and-shine/pkg/observability/tracing"
+)
+
+func (c *Controller) ResetAdminPasswordCmd(username string, resetMFA bool) error {
+	for {
+		password, err := askNewPassword()
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		err = c.resetAdminPassword(&resetadminpassword.Input{
+			Username: username,
+			Password: password,
+			ResetMFA: resetMFA,
+		})
+		if errx.IsCodeIn(err, passwordpolicy.CodeWeakPassword) {
+			printPasswordViolations(err)
+			continue
+		}
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		fmt.Println("Password is reset successfully, all sessions of the admin are revoked")
+		if resetMFA {
+			fmt.Println("Two-factor authentication of the admin is disabled")
+		}
+		return nil
+	}
+}
+
+// resetAdminPassword executes the use case with its own timeout,
+// so time spent at the password prompts doesn't count against it.
+func (c *Controller) resetAdminPassword(input *resetadminpassword.Input) error {
+	const (
+		executionTimeout = 30 * time.Second
+	)
+
+	// Set timeout
+	ctx, cancel := context.WithTimeout(context.Background(), executionTimeout)
+	defer cancel()
+
+	// Set trace ID to context
+	ctx = context.WithValue(ctx, meta.TraceID, tracing.GetStartingTraceID(ctx))
+
+	return errx.Wrap(c.usecaseContainer.ResetAdminPassword().Execute(ctx, input))
+}
+
+// askNewPassword asks the password twice, so a typo doesn't lock the admin out again.
+func askNewPassword() (string, error) {
+	for {
+		password, err := askPassword()
+		if err != nil {
+			return "", errx.Wrap(err)
+		}
+
+		fmt.Print("Repeat password: ")
+		var repeated string
+		repeated, err = readPassword()
+		if err != nil {
+			return "", errx.Wrap(err)
+		}
+
+		if password != repeated {
+			fmt.Println("Passwords do not match")
+			continue
+		}
+
+		return password, nil
+	}
+}
@@ -3,14 +3,18 @@ package http
 import (
 	"go-enterprise-blueprint/internal/modules/auth/usecase"
 	"go-enterprise-blueprint/internal/portal"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"go-enterprise-blueprint/internal/portal/auth/guard"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rise-and-shine/pkg/http/server"
+	"github.com/rise-and-shine/pkg/http/server/forward"
 )
 
 type Controller struct {
 	usecaseContainer *usecase.Container
 	portalContainer  *portal.Container
+	guard            *guard.Guard
 }
 
 func NewContoller(
@@ -21,6 +25,7 @@ func NewContoller(
 	ctrl := &Controller{
 		usecaseContainer,
 		portalContainer,
+		guard.New(portalContainer.Auth),
 	}
 
 	httpServer.RegisterRouter(ctrl.initRoutes)
@@ -30,9 +35,87 @@ func NewContoller(
 func (c *Controller) initRoutes(r fiber.Router) {
 	v1 := r.Group("/auth/v1")
 
+	// Public routes
 	v1.Get("/health", func(ctx *fiber.Ctx) error {
 		return ctx.JSON(fiber.Map{"status": "OK"})
 	})
+	v1.Post("/admin-login", forward.ToUserAction(c.usecaseContainer.AdminLogin()))
+	v1.Post("/admin-refresh-token", forward.ToUserAction(c.usecaseContainer.AdminRefreshToken()))
+	v1.Post("/admin-mfa-verify", forward.ToUserAction(c.usecaseContainer.AdminMFAVerify()))
+	v1.Post("/admin-reset-password", forward.ToUserAction(c.usecaseContainer.AdminResetPassword()))
+	v1.Post("/user-register", forward.ToUserAction(c.usecaseContainer.UserRegister()))
+	v1.Post("/user-login", forward.ToUserAction(c.usecaseContainer.UserLogin()))
+	v1.Post("/user-refresh-token", forward.ToUserAction(c.usecaseContainer.UserRefreshToken()))
+
+	// End-user routes, accessible only by user actors
+	me := v1.Group("/me")
+	userOnly := c.guard.RequireActorType(auth.ActorTypeUser)
+	me.Post("/logout", userOnly, forward.ToUserAction(c.usecaseContainer.UserLogout()))
+	me.Get("/get-profile", userOnly, forward.ToUserAction(c.usecaseContainer.GetProfile()))
+	me.Post("/update-profile", userOnly, forward.ToUserAction(c.usecaseContainer.UpdateProfile()))
+
+	// Session routes, accessible by actors authenticated with a session
+	sessionOwner := c.guard.RequireActorType(auth.ActorTypeUser, auth.ActorTypeAdmin)
+	v1.Get("/get-my-sessions", sessionOwner, forward.ToUserAction(c.usecaseContainer.GetMySessions()))
+	v1.Post("/logout-other-sessions", sessionOwner, forward.ToUserAction(c.usecaseContainer.LogoutOtherSessions()))
+	v1.Post("/delete-user-session", sessionOwner, forward.ToUserAction(c.usecaseContainer.DeleteUserSession()))
+
+	// Management routes
+	c.initManagementRoutes(v1)
+}
+
+// initManagementRoutes registers routes which are never accessible by user actors
+// even if they are granted a permission.
+// Guards are attached per route rather than to a group without a prefix,
+// since such a group's guard would run for every path under the prefix, including unknown ones.
+func (c *Controller) initManagementRoutes(r fiber.Router) {
+	mgmt := c.guard.RequireActorType(auth.ActorTypeAdmin, auth.ActorTypeServiceAcc)
+	superadmin := c.guard.RequirePermission(auth.PermissionSuperadmin)
+	adminOnly := c.guard.RequireActorType(auth.ActorTypeAdmin)
+
+	// Admin
+	r.Post("/create-admin", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.CreateAdmin()))
+	r.Post("/update-admin", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.UpdateAdmin()))
+	r.Post("/disable-admin", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.DisableAdmin()))
+	r.Get("/get-admins", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.GetAdmins()))
+	r.Post("/admin-mfa-enroll", adminOnly, forward.ToUserAction(c.usecaseContainer.AdminMFAEnroll()))
+	r.Post("/admin-mfa-confirm", adminOnly, forward.ToUserAction(c.usecaseContainer.AdminMFAConfirm()))
+	r.Post("/unlock-account", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.UnlockAccount()))
+	r.Post("/admin-change-password", adminOnly, forward.ToUserAction(c.usecaseContainer.AdminChangePassword()))
+	r.Post("/create-password-reset", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.CreatePasswordReset()))
+
+	// RBAC
+	r.Get("/get-permissions", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.GetPermissions()))
+	r.Post("/set-role-permission", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.SetRolePermission()))
+	r.Post("/set-actor-permission", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.SetActorPermission()))
+	r.Post("/set-actor-role", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.SetActorRole()))
+	r.Post("/set-role-parents", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.SetRoleParents()))
+	r.Get(
+		"/explain-actor-permission",
+		mgmt,
+		superadmin,
+		forward.ToUserAction(c.usecaseContainer.ExplainActorPermission()),
+	)
+
+	// Role
+	r.Post("/create-role", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.CreateRole()))
+	r.Post("/update-role", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.UpdateRole()))
+	r.Post("/delete-role", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.DeleteRole()))
+	r.Get("/get-roles", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.GetRoles()))
+
+	// Service account
+	r.Post("/create-service-account", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.CreateServiceAccount()))
+	r.Post("/create-api-key", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.CreateAPIKey()))
+	r.Post("/rotate-api-key", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.RotateAPIKey()))
+	r.Post("/revoke-api-key", mgmt, superadmin, forward.ToUserAction(c.usecaseContainer.RevokeAPIKey()))
+
+	// Session
+	r.Post(
+		"/delete-user-all-sessions",
+		mgmt,
+		superadmin,
+		forward.ToUserAction(c.usecaseContainer.DeleteUserAllSessions()),
+	)
 
 	// Add your routes here...
 }
@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/rise-and-shine/pkg/pg"
+)
+
+const (
+	CodeAPIKeyNotFound       = "API_KEY_NOT_FOUND"
+	CodeAPIKeyAlreadyRevoked = "API_KEY_ALREADY_REVOKED"
+	CodeAPIKeyHashConflict   = "API_KEY_HASH_CONFLICT"
+)
+
+// APIKey is a long-lived credential of a service account.
+// Only a hash of the key is stored, the plain key is shown once on creation.
+type APIKey struct {
+	pg.BaseModel
+
+	ID int64 `json:"id" bun:"id,pk,autoincrement"`
+
+	ServiceAccountID string `json:"service_account_id"`
+
+	// Prefix is a first few characters of the plain key, used to identify the key in listings and logs.
+	Prefix  string `json:"prefix"`
+	KeyHash string `json:"-"`
+
+	// ExpiresAt is nil for keys which never expire.
+	ExpiresAt *time.Time `json:"expires_at"`
+	// RevokedAt is set when the key is revoked or replaced by rotation.
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+// IsExpired reports whether the key is expired at the given time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && now.After(*k.ExpiresAt)
+}
@@ -0,0 +1,27 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
+
+type Filter struct {
+	ID               *int64
+	ServiceAccountID *string
+	KeyHash          *string
+	IsRevoked        *bool
+
+	Limit  int
+	Offset int
+}
+
+type Repo interface {
+	repogen.Repo[APIKey, Filter]
+
+	// Revoke sets revocation time of the key, only if the key is not revoked yet.
+	// Returns false if the key was already revoked, a concurrent revocation of the key
+	// waits until the other one is committed or rolled back.
+	Revoke(ctx context.Context, id int64, revokedAt time.Time) (bool, error)
+}
@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"go-enterprise-blueprint/internal/modules/auth/domain/lockout"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/passwordreset"
 	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
 	"go-enterprise-blueprint/internal/modules/auth/domain/session"
 	"go-enterprise-blueprint/internal/modules/auth/domain/uow"
@@ -10,13 +14,24 @@ import (
 // Container holds domain interfaces.
 // It acts as a dependency injection container for the domain layer.
 type Container struct {
-	adminRepo           user.AdminRepo
-	sessionRepo         session.Repo
-	roleRepo            rbac.RoleRepo
-	rolePermissionRepo  rbac.RolePermissionRepo
-	actorRoleRepo       rbac.ActorRoleRepo
-	actorPermissionRepo rbac.ActorPermissionRepo
-	uowFactory          uow.Factory
+	adminRepo              user.AdminRepo
+	sessionRepo            session.Repo
+	roleRepo               rbac.RoleRepo
+	rolePermissionRepo     rbac.RolePermissionRepo
+	roleParentRepo         rbac.RoleParentRepo
+	actorRoleRepo          rbac.ActorRoleRepo
+	actorPermissionRepo    rbac.ActorPermissionRepo
+	serviceAccountRepo     user.ServiceAccountRepo
+	apiKeyRepo             apikey.Repo
+	userRepo               user.UserRepo
+	loginChallengeRepo     mfa.LoginChallengeRepo
+	recoveryCodeRepo       mfa.RecoveryCodeRepo
+	loginFailureRepo       lockout.Repo
+	passwordResetTokenRepo passwordreset.Repo
+	uowFactory             uow.Factory
+
+	permissionChangeNotifier rbac.PermissionChangeNotifier
+	grantEventPublisher      rbac.GrantEventPublisher
 }
 
 func NewContainer(
@@ -24,18 +39,38 @@ func NewContainer(
 	sessionRepo session.Repo,
 	roleRepo rbac.RoleRepo,
 	rolePermissionRepo rbac.RolePermissionRepo,
+	roleParentRepo rbac.RoleParentRepo,
 	actorRoleRepo rbac.ActorRoleRepo,
 	actorPermissionRepo rbac.ActorPermissionRepo,
+	serviceAccountRepo user.ServiceAccountRepo,
+	apiKeyRepo apikey.Repo,
+	userRepo user.UserRepo,
+	loginChallengeRepo mfa.LoginChallengeRepo,
+	recoveryCodeRepo mfa.RecoveryCodeRepo,
+	loginFailureRepo lockout.Repo,
+	passwordResetTokenRepo passwordreset.Repo,
 	uowFactory uow.Factory,
+	permissionChangeNotifier rbac.PermissionChangeNotifier,
+	grantEventPublisher rbac.GrantEventPublisher,
 ) *Container {
 	return &Container{
 		adminRepo,
 		sessionRepo,
 		roleRepo,
 		rolePermissionRepo,
+		roleParentRepo,
 		actorRoleRepo,
 		actorPermissionRepo,
+		serviceAccountRepo,
+		apiKeyRepo,
+		userRepo,
+		loginChallengeRepo,
+		recoveryCodeRepo,
+		loginFailureRepo,
+		passwordResetTokenRepo,
 		uowFactory,
+		permissionChangeNotifier,
+		grantEventPublisher,
 	}
 }
 
@@ -55,6 +90,10 @@ func (c *Container) RolePermissionRepo() rbac.RolePermissionRepo {
 	return c.rolePermissionRepo
 }
 
+func (c *Container) RoleParentRepo() rbac.RoleParentRepo {
+	return c.roleParentRepo
+}
+
 func (c *Container) ActorRoleRepo() rbac.ActorRoleRepo {
 	return c.actorRoleRepo
 }
@@ -63,6 +102,42 @@ func (c *Container) ActorPermissionRepo() rbac.ActorPermissionRepo {
 	return c.actorPermissionRepo
 }
 
+func (c *Container) ServiceAccountRepo() user.ServiceAccountRepo {
+	return c.serviceAccountRepo
+}
+
+func (c *Container) APIKeyRepo() apikey.Repo {
+	return c.apiKeyRepo
+}
+
+func (c *Container) UserRepo() user.UserRepo {
+	return c.userRepo
+}
+
+func (c *Container) LoginChallengeRepo() mfa.LoginChallengeRepo {
+	return c.loginChallengeRepo
+}
+
+func (c *Container) RecoveryCodeRepo() mfa.RecoveryCodeRepo {
+	return c.recoveryCodeRepo
+}
+
+func (c *Container) LoginFailureRepo() lockout.Repo {
+	return c.loginFailureRepo
+}
+
+func (c *Container) PasswordResetTokenRepo() passwordreset.Repo {
+	return c.passwordResetTokenRepo
+}
+
 func (c *Container) UOWFactory() uow.Factory {
 	return c.uowFactory
 }
+
+func (c *Container) PermissionChangeNotifier() rbac.PermissionChangeNotifier {
+	return c.permissionChangeNotifier
+}
+
+func (c *Container) GrantEventPublisher() rbac.GrantEventPublisher {
+	return c.grantEventPublisher
+}
@@ -0,0 +1,44 @@
+package lockout
+
+import (
+	"time"
+
+	"github.com/rise-and-shine/pkg/pg"
+)
+
+const (
+	CodeLoginFailureNotFound = "LOGIN_FAILURE_NOT_FOUND"
+	CodeAccountLocked        = "ACCOUNT_LOCKED"
+	CodeLoginThrottled       = "LOGIN_THROTTLED"
+)
+
+// Scope is a kind of subject failed login attempts are counted for.
+type Scope string
+
+const (
+	// ScopeUsername counts failures of a login identifier (admin's username or user's email).
+	ScopeUsername Scope = "username"
+	// ScopeIPAddress counts failures of a client IP address across all login identifiers.
+	ScopeIPAddress Scope = "ip_address"
+)
+
+// LoginFailure counts recent consecutive failed login attempts of a subject.
+// A single row exists per actor type, scope and subject, it's shared by all running instances.
+type LoginFailure struct {
+	pg.BaseModel
+
+	ID int64 `json:"id" bun:"id,pk,autoincrement"`
+
+	ActorType string `json:"actor_type"`
+	Scope     string `json:"scope"`
+	Subject   string `json:"subject"`
+
+	FailedAttempts int        `json:"failed_attempts"`
+	LastFailedAt   time.Time  `json:"last_failed_at"`
+	LockedUntil    *time.Time `json:"locked_until"`
+}
+
+// IsLocked reports whether the subject is locked out at the given time.
+func (f *LoginFailure) IsLocked(now time.Time) bool {
+	return f.LockedUntil != nil && now.Before(*f.LockedUntil)
+}
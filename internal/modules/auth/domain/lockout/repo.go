@@ -0,0 +1,31 @@
+package lockout
+
+import (
+	"context"
+	"time"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
+
+type Filter struct {
+	ID        *int64
+	ActorType *string
+	Scope     *string
+	Subject   *string
+
+	Limit  int
+	Offset int
+}
+
+type Repo interface {
+	repogen.Repo[LoginFailure, Filter]
+
+	// Increment atomically counts a failed attempt of the failure's subject at failure.LastFailedAt,
+	// creating the counter if it doesn't exist. A counter whose last failure is before windowStart
+	// and which is not locked out starts over, so old failures are forgotten. Returns the counter after the increment.
+	Increment(ctx context.Context, failure *LoginFailure, windowStart time.Time) (*LoginFailure, error)
+
+	// DeleteStale deletes at most limit counters whose last failure is before windowStart
+	// and which are not locked out at now, oldest first. Returns the number of deleted counters.
+	DeleteStale(ctx context.Context, windowStart, now time.Time, limit int) (int, error)
+}
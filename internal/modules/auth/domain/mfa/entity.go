@@ -0,0 +1,42 @@
+package mfa
+
+import (
+	"time"
+
+	"github.com/rise-and-shine/pkg/pg"
+)
+
+const (
+	CodeMFAAlreadyEnabled    = "MFA_ALREADY_ENABLED"
+	CodeMFANotEnrolled       = "MFA_NOT_ENROLLED"
+	CodeInvalidMFACode       = "INVALID_MFA_CODE"
+	CodeChallengeNotFound    = "MFA_CHALLENGE_NOT_FOUND"
+	CodeInvalidChallenge     = "INVALID_MFA_CHALLENGE"
+	CodeRecoveryCodeNotFound = "RECOVERY_CODE_NOT_FOUND"
+)
+
+// LoginChallenge is a pending second step of an admin login.
+// It is created after a successful password check and exchanged for a session with a valid TOTP or recovery code.
+type LoginChallenge struct {
+	pg.BaseModel
+
+	ID int64 `json:"id" bun:"id,pk,autoincrement"`
+
+	AdminID   string    `json:"admin_id"`
+	TokenHash string    `json:"-"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Attempts is a number of failed code submissions, the challenge is dropped after too many of them.
+	Attempts int `json:"attempts"`
+}
+
+// RecoveryCode is a single-use code which completes a login challenge when the authenticator is not available.
+type RecoveryCode struct {
+	pg.BaseModel
+
+	ID int64 `json:"id" bun:"id,pk,autoincrement"`
+
+	AdminID  string     `json:"admin_id"`
+	CodeHash string     `json:"-"`
+	UsedAt   *time.Time `json:"used_at"`
+}
@@ -0,0 +1,38 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
+
+type LoginChallengeFilter struct {
+	ID        *int64
+	AdminID   *string
+	TokenHash *string
+
+	Limit  int
+	Offset int
+}
+
+type RecoveryCodeFilter struct {
+	ID       *int64
+	AdminID  *string
+	CodeHash *string
+	IsUsed   *bool
+
+	Limit  int
+	Offset int
+}
+
+type LoginChallengeRepo interface {
+	repogen.Repo[LoginChallenge, LoginChallengeFilter]
+
+	// DeleteByAdmin deletes all pending login challenges of the admin.
+	// Returns the number of deleted challenges.
+	DeleteByAdmin(ctx context.Context, adminID string) (int, error)
+}
+
+type RecoveryCodeRepo interface {
+	repogen.Repo[RecoveryCode, RecoveryCodeFilter]
+}
@@ -0,0 +1,27 @@
+package passwordreset
+
+import (
+	"time"
+
+	"github.com/rise-and-shine/pkg/pg"
+)
+
+const (
+	CodeTokenNotFound = "PASSWORD_RESET_TOKEN_NOT_FOUND"
+	CodeInvalidToken  = "INVALID_PASSWORD_RESET_TOKEN"
+)
+
+// PasswordResetToken is a one-time token issued by a superadmin, which lets an admin set a new password.
+type PasswordResetToken struct {
+	pg.BaseModel
+
+	ID int64 `json:"id" bun:"id,pk,autoincrement"`
+
+	AdminID   string     `json:"admin_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+
+	// CreatedBy is an ID of the superadmin who issued the token.
+	CreatedBy string `json:"created_by"`
+}
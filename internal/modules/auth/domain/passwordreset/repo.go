@@ -0,0 +1,27 @@
+package passwordreset
+
+import (
+	"context"
+	"time"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
+
+type Filter struct {
+	ID        *int64
+	AdminID   *string
+	TokenHash *string
+	IsUsed    *bool
+
+	Limit  int
+	Offset int
+}
+
+type Repo interface {
+	repogen.Repo[PasswordResetToken, Filter]
+
+	// MarkUsed sets usage time of the token, only if the token is not used yet.
+	// Returns false if the token was already used, a concurrent usage of the token
+	// waits until the other one is committed or rolled back.
+	MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error)
+}
@@ -0,0 +1,31 @@
+package rbac
+
+// Diff compares current assignment rows with the desired set of keys.
+// It returns keys which have no row yet (to create) and rows whose key is not desired anymore (to delete).
+// Rows whose key is desired are left untouched.
+func Diff[E any, K comparable](current []E, keyOf func(E) K, desired []K) ([]K, []E) {
+	desiredSet := make(map[K]struct{}, len(desired))
+	for _, k := range desired {
+		desiredSet[k] = struct{}{}
+	}
+
+	var toDelete []E
+	currentSet := make(map[K]struct{}, len(current))
+	for _, e := range current {
+		k := keyOf(e)
+		if _, ok := desiredSet[k]; !ok {
+			toDelete = append(toDelete, e)
+			continue
+		}
+		currentSet[k] = struct{}{}
+	}
+
+	var toCreate []K
+	for _, k := range desired {
+		if _, ok := currentSet[k]; !ok {
+			toCreate = append(toCreate, k)
+		}
+	}
+
+	return toCreate, toDelete
+}
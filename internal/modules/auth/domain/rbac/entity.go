@@ -1,6 +1,7 @@
 package rbac
 
 import (
+	"go-enterprise-blueprint/internal/portal/auth"
 	"slices"
 
 	"github.com/rise-and-shine/pkg/pg"
@@ -12,6 +13,17 @@ const (
 	CodeRolePermissionNotFound  = "ROLE_PERMISSION_NOT_FOUND"
 	CodeActorRoleNotFound       = "ACTOR_ROLE_NOT_FOUND"
 	CodeActorPermissionNotFound = "ACTOR_PERMISSION_NOT_FOUND"
+	CodeRolePermissionConflict  = "ROLE_PERMISSION_CONFLICT"
+	CodeActorRoleConflict       = "ACTOR_ROLE_CONFLICT"
+	CodeActorPermissionConflict = "ACTOR_PERMISSION_CONFLICT"
+	CodeInvalidActorType        = "INVALID_ACTOR_TYPE"
+	CodeInvalidActorID          = "INVALID_ACTOR_ID"
+	CodeRoleParentNotFound      = "ROLE_PARENT_NOT_FOUND"
+	CodeRoleParentConflict      = "ROLE_PARENT_CONFLICT"
+	CodeRoleParentInvalid       = "ROLE_PARENT_INVALID"
+	CodeRoleHierarchyCycle      = "ROLE_HIERARCHY_CYCLE"
+	CodeInvalidGrantValidity    = "INVALID_GRANT_VALIDITY"
+	CodeRoleActorTypeMismatch   = "ROLE_ACTOR_TYPE_MISMATCH"
 )
 
 type ActorType string
@@ -23,9 +35,9 @@ func (at ActorType) IsValid() bool {
 }
 
 const (
-	ActorTypeUser       ActorType = "user"
-	ActorTypeAdmin      ActorType = "admin"
-	ActorTypeServiceAcc ActorType = "service_acc"
+	ActorTypeUser       ActorType = auth.ActorTypeUser
+	ActorTypeAdmin      ActorType = auth.ActorTypeAdmin
+	ActorTypeServiceAcc ActorType = auth.ActorTypeServiceAcc
 )
 
 type Role struct {
@@ -48,8 +60,20 @@ type RolePermission struct {
 	Permission string `json:"permission"`
 }
 
+// RoleParent makes the role inherit permissions of the parent role.
+// A role may have several parents, and parents may have parents of their own.
+type RoleParent struct {
+	pg.BaseModel
+
+	ID int64 `json:"id" bun:"id,pk,autoincrement"`
+
+	RoleID       int64 `json:"role_id"`
+	ParentRoleID int64 `json:"parent_role_id"`
+}
+
 type ActorRole struct {
 	pg.BaseModel
+	Validity
 
 	ID int64 `json:"id" bun:"id,pk,autoincrement"`
 
@@ -61,6 +85,7 @@ type ActorRole struct {
 
 type ActorPermission struct {
 	pg.BaseModel
+	Validity
 
 	ID int64 `json:"id" bun:"id,pk,autoincrement"`
 
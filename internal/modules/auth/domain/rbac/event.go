@@ -0,0 +1,35 @@
+package rbac
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	GrantTypeActorPermission = "actor_permission"
+	GrantTypeActorRole       = "actor_role"
+)
+
+// GrantExpired is published when an expired grant is removed.
+// It may be published more than once for the same grant, consumers should deduplicate by GrantType and GrantID.
+type GrantExpired struct {
+	// GrantType is one of: actor_permission, actor_role.
+	GrantType string    `json:"grant_type"`
+	GrantID   int64     `json:"grant_id"`
+	ActorType ActorType `json:"actor_type"`
+	ActorID   string    `json:"actor_id"`
+
+	// Permission is set for actor_permission grants.
+	Permission string `json:"permission,omitempty"`
+	// RoleID is set for actor_role grants.
+	RoleID int64 `json:"role_id,omitempty"`
+
+	ValidUntil time.Time `json:"valid_until"`
+	RemovedAt  time.Time `json:"removed_at"`
+}
+
+// GrantEventPublisher publishes events about grants to other services.
+type GrantEventPublisher interface {
+	// PublishGrantsExpired publishes the events, all or none of them.
+	PublishGrantsExpired(ctx context.Context, events []GrantExpired) error
+}
@@ -0,0 +1,46 @@
+package rbac
+
+// Hierarchy maps role IDs to IDs of their parent roles.
+type Hierarchy map[int64][]int64
+
+// NewHierarchy builds a hierarchy from role parent rows.
+func NewHierarchy(parents []RoleParent) Hierarchy {
+	h := make(Hierarchy, len(parents))
+	for _, rp := range parents {
+		h[rp.RoleID] = append(h[rp.RoleID], rp.ParentRoleID)
+	}
+	return h
+}
+
+// PathTo returns the shortest chain of role IDs from the role to the ancestor following parent links,
+// both ends included. Returns nil if the ancestor isn't reachable.
+func (h Hierarchy) PathTo(roleID, ancestorID int64) []int64 {
+	prev := map[int64]int64{roleID: roleID}
+	queue := []int64{roleID}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if id == ancestorID {
+			var path []int64
+			for ; id != roleID; id = prev[id] {
+				path = append(path, id)
+			}
+			path = append(path, roleID)
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path
+		}
+
+		for _, parentID := range h[id] {
+			if _, seen := prev[parentID]; !seen {
+				prev[parentID] = id
+				queue = append(queue, parentID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package rbac
+
+import "context"
+
+// PermissionChange describes RBAC data changed by a use case,
+// so permissions cached for the affected actors can be dropped.
+// A zero value means permissions of any actor may have changed.
+type PermissionChange struct {
+	// ActorType and ActorID are set if direct permissions or roles of the actor changed.
+	ActorType ActorType `json:"actor_type,omitempty"`
+	ActorID   string    `json:"actor_id,omitempty"`
+
+	// RoleID is set if permissions of the role changed or the role was deleted.
+	RoleID int64 `json:"role_id,omitempty"`
+}
+
+// PermissionChangeNotifier delivers permission changes to every running instance of the module.
+type PermissionChangeNotifier interface {
+	// Notify publishes the change to all listeners, including the ones of the calling instance.
+	Notify(ctx context.Context, change PermissionChange) error
+
+	// Listen calls handle for every published change until ctx is done or the connection fails.
+	// handle is called with a zero change once listening starts, since changes published before could be missed.
+	Listen(ctx context.Context, handle func(PermissionChange)) error
+}
@@ -1,6 +1,11 @@
 package rbac
 
-import "github.com/rise-and-shine/pkg/repogen"
+import (
+	"context"
+	"time"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
 
 type RoleFilter struct {
 	ID        *int64
@@ -13,8 +18,19 @@ type RoleFilter struct {
 }
 
 type RolePermissionFilter struct {
-	ID     *int64
-	RoleID *int64
+	ID      *int64
+	RoleID  *int64
+	RoleIDs []int64
+
+	Limit  int
+	Offset int
+}
+
+type RoleParentFilter struct {
+	ID           *int64
+	RoleID       *int64
+	RoleIDs      []int64
+	ParentRoleID *int64
 
 	Limit  int
 	Offset int
@@ -31,9 +47,11 @@ type ActorRoleFilter struct {
 }
 
 type ActorPermissionFilter struct {
-	ID        *int64
-	ActorType *ActorType
-	ActorID   *string
+	ID         *int64
+	ActorType  *ActorType
+	ActorID    *string
+	ActorIDs   []string
+	Permission *string
 
 	Limit  int
 	Offset int
@@ -47,10 +65,28 @@ type RolePermissionRepo interface {
 	repogen.Repo[RolePermission, RolePermissionFilter]
 }
 
+type RoleParentRepo interface {
+	repogen.Repo[RoleParent, RoleParentFilter]
+
+	// LockHierarchy blocks concurrent changes of role parents until the transaction ends,
+	// so a cycle check isn't invalidated by a concurrent change. Must be called within a transaction.
+	LockHierarchy(ctx context.Context) error
+}
+
 type ActorRoleRepo interface {
 	repogen.Repo[ActorRole, ActorRoleFilter]
+
+	// DeleteExpired deletes up to limit actor roles expired before the given time and returns them.
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]ActorRole, error)
 }
 
 type ActorPermissionRepo interface {
 	repogen.Repo[ActorPermission, ActorPermissionFilter]
+
+	// DeleteExpired deletes up to limit actor permissions expired before the given time and returns them.
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) ([]ActorPermission, error)
+
+	// LockPermission locks grants of the permission to actors of the type until the end of the transaction,
+	// so concurrent changes which must keep at least one holder of the permission are serialized.
+	LockPermission(ctx context.Context, actorType ActorType, permission string) error
 }
@@ -0,0 +1,74 @@
+package rbac
+
+import (
+	"time"
+
+	"github.com/code19m/errx"
+)
+
+// Validity bounds the period a grant is in effect. A nil bound is open,
+// so a grant without bounds is in effect until it's revoked.
+type Validity struct {
+	// ValidFrom is the moment the grant comes into effect.
+	ValidFrom *time.Time `json:"valid_from"`
+	// ValidUntil is the moment the grant expires.
+	ValidUntil *time.Time `json:"valid_until"`
+}
+
+// IsValidAt reports whether the grant is in effect at t.
+func (v Validity) IsValidAt(t time.Time) bool {
+	if v.ValidFrom != nil && t.Before(*v.ValidFrom) {
+		return false
+	}
+	if v.ValidUntil != nil && !t.Before(*v.ValidUntil) {
+		return false
+	}
+	return true
+}
+
+// NextChangeAfter returns the earliest bound after t, when the grant comes into effect or expires.
+// Returns nil if the grant doesn't change after t.
+func (v Validity) NextChangeAfter(t time.Time) *time.Time {
+	if v.ValidFrom != nil && v.ValidFrom.After(t) {
+		return v.ValidFrom
+	}
+	if v.ValidUntil != nil && v.ValidUntil.After(t) {
+		return v.ValidUntil
+	}
+	return nil
+}
+
+// Equal reports whether both validities have the same bounds.
+func (v Validity) Equal(other Validity) bool {
+	return equalTime(v.ValidFrom, other.ValidFrom) && equalTime(v.ValidUntil, other.ValidUntil)
+}
+
+// Validate checks that the grant expires after it comes into effect and not in the past.
+// Returns CodeInvalidGrantValidity coded error otherwise.
+func (v Validity) Validate(now time.Time) error {
+	if v.ValidUntil == nil {
+		return nil
+	}
+	if v.ValidFrom != nil && !v.ValidUntil.After(*v.ValidFrom) {
+		return errx.New(
+			"valid_until must be after valid_from",
+			errx.WithCode(CodeInvalidGrantValidity),
+			errx.WithDetails(errx.D{"valid_from": v.ValidFrom, "valid_until": v.ValidUntil}),
+		)
+	}
+	if !v.ValidUntil.After(now) {
+		return errx.New(
+			"valid_until must be in the future",
+			errx.WithCode(CodeInvalidGrantValidity),
+			errx.WithDetails(errx.D{"valid_until": v.ValidUntil}),
+		)
+	}
+	return nil
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -7,7 +7,11 @@ import (
 )
 
 const (
-	CodeSessionNotFound = "SESSION_NOT_FOUND"
+	CodeSessionNotFound     = "SESSION_NOT_FOUND"
+	CodeInvalidRefreshToken = "INVALID_REFRESH_TOKEN"
+	CodeRefreshTokenExpired = "REFRESH_TOKEN_EXPIRED"
+	CodeRefreshTokenReused  = "REFRESH_TOKEN_REUSED"
+	CodeSessionIdleTimeout  = "SESSION_IDLE_TIMEOUT"
 )
 
 type Session struct {
@@ -18,11 +22,20 @@ type Session struct {
 	ActorType string `json:"actor_type"`
 	ActorID   string `json:"actor_id"`
 
-	AccessToken           string    `json:"-"`
+	// AccessTokenHash and RefreshTokenHash are keyed hashes of the tokens, plain tokens are never stored.
+	AccessTokenHash       string    `json:"-"`
 	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
-	RefreshToken          string    `json:"-"`
+	RefreshTokenHash      string    `json:"-"`
 	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 
+	// FamilyID groups all sessions produced by rotating refresh tokens of a single login.
+	FamilyID string `json:"family_id"`
+	// Generation is the rotation number of the session within its family, starting from 1.
+	Generation int `json:"generation"`
+	// RotatedAt is set when the session's refresh token was exchanged for a new session.
+	// Presenting the refresh token of a rotated session is treated as a token reuse.
+	RotatedAt *time.Time `json:"rotated_at"`
+
 	IPAddress string `json:"ip_address"`
 	UserAgent string `json:"user_agent"`
 
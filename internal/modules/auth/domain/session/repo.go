@@ -1,13 +1,20 @@
 package session
 
-import "github.com/rise-and-shine/pkg/repogen"
+import (
+	"context"
+	"time"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
 
 type Filter struct {
-	ID           *int64
-	ActorType    *string
-	ActorID      *string
-	AccessToken  *string
-	RefreshToken *string
+	ID               *int64
+	ActorType        *string
+	ActorID          *string
+	AccessTokenHash  *string
+	RefreshTokenHash *string
+	FamilyID         *string
+	IsRotated        *bool
 
 	Limit  int
 	Offset int
@@ -15,4 +22,21 @@ type Filter struct {
 
 type Repo interface {
 	repogen.Repo[Session, Filter]
+
+	// DeleteExpired deletes at most limit sessions whose refresh token expired before expiredBefore,
+	// oldest first. Returns the number of deleted sessions.
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error)
+
+	// DeleteByActor deletes all sessions of the actor, including the rotated ones,
+	// except the sessions of exceptFamilyID when it is not nil. Returns the deleted sessions.
+	DeleteByActor(ctx context.Context, actorType, actorID string, exceptFamilyID *string) ([]Session, error)
+
+	// MarkRotated marks the session as rotated and expires its access token at rotatedAt,
+	// only if the session is not rotated yet. Returns false if the session was already rotated,
+	// a concurrent rotation of the session waits until the other one is committed or rolled back.
+	MarkRotated(ctx context.Context, id int64, rotatedAt time.Time) (bool, error)
+
+	// TouchLastUsedAt sets last usage times of the given session IDs,
+	// an existing time is never moved backwards.
+	TouchLastUsedAt(ctx context.Context, lastUsedAt map[int64]time.Time) error
 }
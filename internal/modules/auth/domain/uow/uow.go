@@ -2,6 +2,9 @@ package uow
 
 import (
 	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/passwordreset"
 	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
 	"go-enterprise-blueprint/internal/modules/auth/domain/session"
 	"go-enterprise-blueprint/internal/modules/auth/domain/user"
@@ -19,10 +22,17 @@ type UnitOfWork interface {
 	// Repository accessors
 	Role() rbac.RoleRepo
 	RolePermission() rbac.RolePermissionRepo
+	RoleParent() rbac.RoleParentRepo
 	ActorRole() rbac.ActorRoleRepo
 	ActorPermission() rbac.ActorPermissionRepo
 	Session() session.Repo
 	Admin() user.AdminRepo
+	User() user.UserRepo
+	LoginChallenge() mfa.LoginChallengeRepo
+	RecoveryCode() mfa.RecoveryCodeRepo
+	PasswordResetToken() passwordreset.Repo
+	ServiceAccount() user.ServiceAccountRepo
+	APIKey() apikey.Repo
 
 	// ApplyChanges finalizes the unit of work, typically committing the underlying transaction.
 	// This method doesn't take context.Context, instead should be used context which is used in unit of work creation
@@ -7,8 +7,24 @@ import (
 )
 
 const (
-	CodeAdminNotFound         = "ADMIN_NOT_FOUND"
-	CodeAdminUsernameConflict = "USERNAME_CONFLICT"
+	CodeAdminNotFound          = "ADMIN_NOT_FOUND"
+	CodeAdminUsernameConflict  = "USERNAME_CONFLICT"
+	CodeAdminDisabled          = "ADMIN_DISABLED"
+	CodeInvalidCredentials     = "INVALID_CREDENTIALS"
+	CodeInvalidCurrentPassword = "INVALID_CURRENT_PASSWORD"
+
+	CodeAdminAlreadyDisabled        = "ADMIN_ALREADY_DISABLED"
+	CodeCannotDisableLastSuperadmin = "CANNOT_DISABLE_LAST_SUPERADMIN"
+	CodeCannotDemoteLastSuperadmin  = "CANNOT_DEMOTE_LAST_SUPERADMIN"
+	CodeAdminNotActiveSuperadmin    = "ADMIN_NOT_ACTIVE_SUPERADMIN"
+
+	CodeServiceAccountNotFound     = "SERVICE_ACCOUNT_NOT_FOUND"
+	CodeServiceAccountNameConflict = "SERVICE_ACCOUNT_NAME_CONFLICT"
+	CodeServiceAccountDisabled     = "SERVICE_ACCOUNT_DISABLED"
+
+	CodeUserNotFound      = "USER_NOT_FOUND"
+	CodeUserEmailConflict = "EMAIL_CONFLICT"
+	CodeUserDisabled      = "USER_DISABLED"
 )
 
 type Admin struct {
@@ -21,16 +37,44 @@ type Admin struct {
 
 	IsActive     bool       `json:"is_active"`
 	LastActiveAt *time.Time `json:"last_active_at"`
+
+	// MFASecret is an encrypted TOTP secret. It is set on enrollment and is pending until MFAEnabledAt is set.
+	MFASecret *string `json:"-"`
+	// MFAEnabledAt is set when the enrollment is confirmed, since then login requires a second factor.
+	MFAEnabledAt *time.Time `json:"mfa_enabled_at"`
+	// MFALastUsedStep is the TOTP time step of the last accepted code, used to reject code replays.
+	MFALastUsedStep int64 `json:"-"`
 }
 
+// IsMFAEnabled reports whether login of the admin requires a second factor.
+func (a *Admin) IsMFAEnabled() bool {
+	return a.MFAEnabledAt != nil
+}
+
+// ServiceAccount is a non-human actor, e.g. an internal batch job, authenticated by API keys.
 type ServiceAccount struct {
 	pg.BaseModel
 
-	// TODO: implement service account entity if needed in your project
+	ID string `json:"id" bun:"id,pk,autoincrement"`
+
+	// Name is a unique name of the service account
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	IsActive bool `json:"is_active"`
 }
 
+// User is an end-user (customer) account registered by the user itself.
 type User struct {
 	pg.BaseModel
 
-	// TODO: implement user entity if needed in your project
+	ID string `json:"id" bun:"id,pk,autoincrement"`
+
+	// Email is a unique lowercased email of the user, used as login
+	Email        string `json:"email"`
+	PasswordHash string `json:"-"`
+	FullName     string `json:"full_name"`
+
+	IsActive     bool       `json:"is_active"`
+	LastActiveAt *time.Time `json:"last_active_at"`
 }
@@ -1,10 +1,35 @@
 package user
 
-import "github.com/rise-and-shine/pkg/repogen"
+import (
+	"context"
+	"time"
+
+	"github.com/rise-and-shine/pkg/repogen"
+)
 
 type AdminFilter struct {
+	ID             *string
+	IDs            []string
+	Username       *string
+	UsernamePrefix *string
+	IsActive       *bool
+
+	Limit  int
+	Offset int
+}
+
+type ServiceAccountFilter struct {
 	ID       *string
-	Username *string
+	Name     *string
+	IsActive *bool
+
+	Limit  int
+	Offset int
+}
+
+type UserFilter struct {
+	ID       *string
+	Email    *string
 	IsActive *bool
 
 	Limit  int
@@ -13,4 +38,20 @@ type AdminFilter struct {
 
 type AdminRepo interface {
 	repogen.Repo[Admin, AdminFilter]
+
+	// TouchLastActiveAt sets last activity times of the given IDs,
+	// an existing time is never moved backwards.
+	TouchLastActiveAt(ctx context.Context, lastActiveAt map[string]time.Time) error
+}
+
+type ServiceAccountRepo interface {
+	repogen.Repo[ServiceAccount, ServiceAccountFilter]
+}
+
+type UserRepo interface {
+	repogen.Repo[User, UserFilter]
+
+	// TouchLastActiveAt sets last activity times of the given IDs,
+	// an existing time is never moved backwards.
+	TouchLastActiveAt(ctx context.Context, lastActiveAt map[string]time.Time) error
 }
@@ -0,0 +1,93 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"sync"
+
+	"github.com/code19m/errx"
+	kafkapkg "github.com/rise-and-shine/pkg/kafka"
+)
+
+const headerEventType = "event_type"
+
+// EventTypeGrantExpired is a value of the event_type header of rbac.GrantExpired events.
+const EventTypeGrantExpired = "auth.grant_expired"
+
+type Config struct {
+	// GrantEventsTopic is a topic events about grants are produced to. Default is "auth-grant-events".
+	GrantEventsTopic string `yaml:"grant_events_topic" validate:"required" default:"auth-grant-events"`
+}
+
+// GrantEventPublisher produces grant events to Kafka.
+// The producer is connected on the first publish, so processes which never publish don't need a broker.
+type GrantEventPublisher struct {
+	cfg          Config
+	brokerConfig kafkapkg.BrokerConfig
+
+	mu       sync.Mutex
+	producer *kafkapkg.Producer
+}
+
+func NewGrantEventPublisher(cfg Config, brokerConfig kafkapkg.BrokerConfig) *GrantEventPublisher {
+	return &GrantEventPublisher{
+		cfg:          cfg,
+		brokerConfig: brokerConfig,
+	}
+}
+
+func (p *GrantEventPublisher) PublishGrantsExpired(ctx context.Context, events []rbac.GrantExpired) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]kafkapkg.Message, 0, len(events))
+	for _, e := range events {
+		value, err := json.Marshal(e)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+		// Keyed by actor, so events of an actor are consumed in order
+		messages = append(messages, kafkapkg.Message{
+			Key:     []byte(e.ActorID),
+			Value:   value,
+			Headers: map[string]string{headerEventType: EventTypeGrantExpired},
+		})
+	}
+
+	producer, err := p.getProducer()
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	return errx.Wrap(producer.SendMessages(ctx, messages))
+}
+
+// Close closes the producer if it was connected.
+func (p *GrantEventPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.producer == nil {
+		return nil
+	}
+	err := p.producer.Close()
+	p.producer = nil
+	return errx.Wrap(err)
+}
+
+func (p *GrantEventPublisher) getProducer() (*kafkapkg.Producer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.producer != nil {
+		return p.producer, nil
+	}
+
+	producer, err := kafkapkg.NewProducer(p.brokerConfig, p.cfg.GrantEventsTopic)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	p.producer = producer
+	return producer, nil
+}
@@ -1,18 +1,71 @@
 package postgres
 
 import (
+	"context"
 	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"time"
 
+	"github.com/code19m/errx"
 	"github.com/rise-and-shine/pkg/repogen"
 	"github.com/uptrace/bun"
 )
 
+type actorPermissionRepo struct {
+	*repogen.PgRepo[rbac.ActorPermission, rbac.ActorPermissionFilter]
+
+	idb bun.IDB
+}
+
 func NewActorPermissionRepo(idb bun.IDB) rbac.ActorPermissionRepo {
-	return repogen.NewPgRepoBuilder[rbac.ActorPermission, rbac.ActorPermissionFilter](idb).
-		WithSchemaName(schemaName).
-		WithNotFoundCode(rbac.CodeActorPermissionNotFound).
-		WithFilterFunc(actorPermissionFilterFunc).
-		Build()
+	return &actorPermissionRepo{
+		PgRepo: repogen.NewPgRepoBuilder[rbac.ActorPermission, rbac.ActorPermissionFilter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(rbac.CodeActorPermissionNotFound).
+			WithConflictCodesMap(map[string]string{
+				"uq_actor_permissions_actor_permission": rbac.CodeActorPermissionConflict,
+			}).
+			WithFilterFunc(actorPermissionFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *actorPermissionRepo) DeleteExpired(
+	ctx context.Context,
+	expiredBefore time.Time,
+	limit int,
+) ([]rbac.ActorPermission, error) {
+	batch := r.idb.NewSelect().
+		TableExpr("?.actor_permissions", bun.Ident(schemaName)).
+		Column("id").
+		Where("valid_until <= ?", expiredBefore).
+		OrderExpr("valid_until ASC").
+		Limit(limit)
+
+	var deleted []rbac.ActorPermission
+	_, err := r.idb.NewDelete().
+		Model(&deleted).
+		ModelTableExpr("?.actor_permissions AS actor_permission", bun.Ident(schemaName)).
+		Where("id IN (?)", batch).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return deleted, nil
+}
+
+func (r *actorPermissionRepo) LockPermission(ctx context.Context, actorType rbac.ActorType, permission string) error {
+	// Rows are locked in the same order by every caller, so concurrent callers don't deadlock
+	_, err := r.idb.NewSelect().
+		TableExpr("?.actor_permissions", bun.Ident(schemaName)).
+		Column("id").
+		Where("actor_type = ?", actorType).
+		Where("permission = ?", permission).
+		OrderExpr("id ASC").
+		For("UPDATE").
+		Exec(ctx)
+	return errx.Wrap(err)
 }
 
 func actorPermissionFilterFunc(q *bun.SelectQuery, f rbac.ActorPermissionFilter) *bun.SelectQuery {
@@ -25,6 +78,12 @@ func actorPermissionFilterFunc(q *bun.SelectQuery, f rbac.ActorPermissionFilter)
 	if f.ActorID != nil {
 		q = q.Where("actor_id = ?", *f.ActorID)
 	}
+	if len(f.ActorIDs) > 0 {
+		q = q.Where("actor_id IN (?)", bun.In(f.ActorIDs))
+	}
+	if f.Permission != nil {
+		q = q.Where("permission = ?", *f.Permission)
+	}
 	if f.Limit > 0 {
 		q = q.Limit(f.Limit)
 	}
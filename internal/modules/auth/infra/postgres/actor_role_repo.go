@@ -1,18 +1,58 @@
 package postgres
 
 import (
+	"context"
 	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"time"
 
+	"github.com/code19m/errx"
 	"github.com/rise-and-shine/pkg/repogen"
 	"github.com/uptrace/bun"
 )
 
+type actorRoleRepo struct {
+	*repogen.PgRepo[rbac.ActorRole, rbac.ActorRoleFilter]
+
+	idb bun.IDB
+}
+
 func NewActorRoleRepo(idb bun.IDB) rbac.ActorRoleRepo {
-	return repogen.NewPgRepoBuilder[rbac.ActorRole, rbac.ActorRoleFilter](idb).
-		WithSchemaName(schemaName).
-		WithNotFoundCode(rbac.CodeActorRoleNotFound).
-		WithFilterFunc(actorRoleFilterFunc).
-		Build()
+	return &actorRoleRepo{
+		PgRepo: repogen.NewPgRepoBuilder[rbac.ActorRole, rbac.ActorRoleFilter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(rbac.CodeActorRoleNotFound).
+			WithConflictCodesMap(map[string]string{
+				"uq_actor_roles_actor_role": rbac.CodeActorRoleConflict,
+			}).
+			WithFilterFunc(actorRoleFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *actorRoleRepo) DeleteExpired(
+	ctx context.Context,
+	expiredBefore time.Time,
+	limit int,
+) ([]rbac.ActorRole, error) {
+	batch := r.idb.NewSelect().
+		TableExpr("?.actor_roles", bun.Ident(schemaName)).
+		Column("id").
+		Where("valid_until <= ?", expiredBefore).
+		OrderExpr("valid_until ASC").
+		Limit(limit)
+
+	var deleted []rbac.ActorRole
+	_, err := r.idb.NewDelete().
+		Model(&deleted).
+		ModelTableExpr("?.actor_roles AS actor_role", bun.Ident(schemaName)).
+		Where("id IN (?)", batch).
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return deleted, nil
 }
 
 func actorRoleFilterFunc(q *bun.SelectQuery, f rbac.ActorRoleFilter) *bun.SelectQuery {
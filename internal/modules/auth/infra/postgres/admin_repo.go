@@ -1,33 +1,76 @@
 package postgres
 
 import (
+	"context"
 	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"time"
 
+	"github.com/code19m/errx"
 	"github.com/rise-and-shine/pkg/repogen"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
+type adminRepo struct {
+	*repogen.PgRepo[user.Admin, user.AdminFilter]
+
+	idb bun.IDB
+}
+
 func NewAdminRepo(idb bun.IDB) user.AdminRepo {
-	return repogen.NewPgRepoBuilder[user.Admin, user.AdminFilter](idb).
-		WithSchemaName(schemaName).
-		WithNotFoundCode(user.CodeAdminNotFound).
-		WithConflictCodesMap(map[string]string{
-			"admins_username_key": user.CodeAdminUsernameConflict,
-		}).
-		WithFilterFunc(adminFilterFunc).
-		Build()
+	return &adminRepo{
+		PgRepo: repogen.NewPgRepoBuilder[user.Admin, user.AdminFilter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(user.CodeAdminNotFound).
+			WithConflictCodesMap(map[string]string{
+				"admins_username_key": user.CodeAdminUsernameConflict,
+			}).
+			WithFilterFunc(adminFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *adminRepo) TouchLastActiveAt(ctx context.Context, lastActiveAt map[string]time.Time) error {
+	if len(lastActiveAt) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(lastActiveAt))
+	times := make([]time.Time, 0, len(lastActiveAt))
+	for id, t := range lastActiveAt {
+		ids = append(ids, id)
+		times = append(times, t)
+	}
+
+	_, err := r.idb.NewUpdate().
+		TableExpr("?.admins AS a", bun.Ident(schemaName)).
+		TableExpr("unnest(?::uuid[], ?::timestamptz[]) AS v (id, last_active_at)", pgdialect.Array(ids), pgdialect.Array(times)).
+		Set("last_active_at = v.last_active_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("a.id = v.id").
+		Where("a.last_active_at IS NULL OR a.last_active_at < v.last_active_at").
+		Exec(ctx)
+	return errx.Wrap(err)
 }
 
 func adminFilterFunc(q *bun.SelectQuery, f user.AdminFilter) *bun.SelectQuery {
 	if f.ID != nil {
 		q = q.Where("id = ?", *f.ID)
 	}
+	if len(f.IDs) > 0 {
+		q = q.Where("id IN (?)", bun.In(f.IDs))
+	}
 	if f.Username != nil {
 		q = q.Where("username = ?", *f.Username)
 	}
+	if f.UsernamePrefix != nil {
+		q = q.Where("username LIKE ?", escapeLike(*f.UsernamePrefix)+"%")
+	}
 	if f.IsActive != nil {
 		q = q.Where("is_active = ?", *f.IsActive)
 	}
+	q = q.Order("username ASC")
 	if f.Limit > 0 {
 		q = q.Limit(f.Limit)
 	}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+type apiKeyRepo struct {
+	*repogen.PgRepo[apikey.APIKey, apikey.Filter]
+
+	idb bun.IDB
+}
+
+func NewAPIKeyRepo(idb bun.IDB) apikey.Repo {
+	return &apiKeyRepo{
+		PgRepo: repogen.NewPgRepoBuilder[apikey.APIKey, apikey.Filter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(apikey.CodeAPIKeyNotFound).
+			WithConflictCodesMap(map[string]string{
+				"api_keys_key_hash_key": apikey.CodeAPIKeyHashConflict,
+			}).
+			WithFilterFunc(apiKeyFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *apiKeyRepo) Revoke(ctx context.Context, id int64, revokedAt time.Time) (bool, error) {
+	res, err := r.idb.NewUpdate().
+		TableExpr("?.api_keys", bun.Ident(schemaName)).
+		Set("revoked_at = ?", revokedAt).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Where("revoked_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+	return updated > 0, nil
+}
+
+func apiKeyFilterFunc(q *bun.SelectQuery, f apikey.Filter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.ServiceAccountID != nil {
+		q = q.Where("service_account_id = ?", *f.ServiceAccountID)
+	}
+	if f.KeyHash != nil {
+		q = q.Where("key_hash = ?", *f.KeyHash)
+	}
+	if f.IsRevoked != nil {
+		if *f.IsRevoked {
+			q = q.Where("revoked_at IS NOT NULL")
+		} else {
+			q = q.Where("revoked_at IS NULL")
+		}
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
@@ -0,0 +1,10 @@
+package postgres
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes LIKE pattern special characters so the value is matched literally.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
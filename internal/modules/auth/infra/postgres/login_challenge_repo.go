@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+type loginChallengeRepo struct {
+	*repogen.PgRepo[mfa.LoginChallenge, mfa.LoginChallengeFilter]
+
+	idb bun.IDB
+}
+
+func NewLoginChallengeRepo(idb bun.IDB) mfa.LoginChallengeRepo {
+	return &loginChallengeRepo{
+		PgRepo: repogen.NewPgRepoBuilder[mfa.LoginChallenge, mfa.LoginChallengeFilter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(mfa.CodeChallengeNotFound).
+			WithFilterFunc(loginChallengeFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *loginChallengeRepo) DeleteByAdmin(ctx context.Context, adminID string) (int, error) {
+	res, err := r.idb.NewDelete().
+		TableExpr("?.login_challenges", bun.Ident(schemaName)).
+		Where("admin_id = ?", adminID).
+		Exec(ctx)
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+	return int(deleted), nil
+}
+
+func loginChallengeFilterFunc(q *bun.SelectQuery, f mfa.LoginChallengeFilter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.AdminID != nil {
+		q = q.Where("admin_id = ?", *f.AdminID)
+	}
+	if f.TokenHash != nil {
+		q = q.Where("token_hash = ?", *f.TokenHash)
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/lockout"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+type loginFailureRepo struct {
+	*repogen.PgRepo[lockout.LoginFailure, lockout.Filter]
+
+	idb bun.IDB
+}
+
+func NewLoginFailureRepo(idb bun.IDB) lockout.Repo {
+	return &loginFailureRepo{
+		PgRepo: repogen.NewPgRepoBuilder[lockout.LoginFailure, lockout.Filter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(lockout.CodeLoginFailureNotFound).
+			WithFilterFunc(loginFailureFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *loginFailureRepo) Increment(
+	ctx context.Context,
+	failure *lockout.LoginFailure,
+	windowStart time.Time,
+) (*lockout.LoginFailure, error) {
+	failure.FailedAttempts = 1
+	failure.LockedUntil = nil
+
+	// Counter starts over if its failures are forgotten, a lockout is kept until it expires
+	startsOver := bun.SafeQuery(
+		"login_failure.last_failed_at < ? AND "+
+			"(login_failure.locked_until IS NULL OR login_failure.locked_until <= EXCLUDED.last_failed_at)",
+		windowStart,
+	)
+
+	_, err := r.idb.NewInsert().
+		Model(failure).
+		ModelTableExpr("?.login_failures AS login_failure", bun.Ident(schemaName)).
+		On("CONFLICT (actor_type, scope, subject) DO UPDATE").
+		Set(`failed_attempts = CASE WHEN ? THEN 1
+			ELSE login_failure.failed_attempts + 1 END`, startsOver).
+		Set(`locked_until = CASE WHEN ? THEN NULL
+			ELSE login_failure.locked_until END`, startsOver).
+		Set("last_failed_at = EXCLUDED.last_failed_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return failure, nil
+}
+
+func (r *loginFailureRepo) DeleteStale(ctx context.Context, windowStart, now time.Time, limit int) (int, error) {
+	batch := r.idb.NewSelect().
+		TableExpr("?.login_failures", bun.Ident(schemaName)).
+		Column("id").
+		Where("last_failed_at < ?", windowStart).
+		Where("locked_until IS NULL OR locked_until <= ?", now).
+		OrderExpr("last_failed_at ASC").
+		Limit(limit)
+
+	res, err := r.idb.NewDelete().
+		TableExpr("?.login_failures", bun.Ident(schemaName)).
+		Where("id IN (?)", batch).
+		Exec(ctx)
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+	return int(deleted), nil
+}
+
+func loginFailureFilterFunc(q *bun.SelectQuery, f lockout.Filter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.ActorType != nil {
+		q = q.Where("actor_type = ?", *f.ActorType)
+	}
+	if f.Scope != nil {
+		q = q.Where("scope = ?", *f.Scope)
+	}
+	if f.Subject != nil {
+		q = q.Where("subject = ?", *f.Subject)
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
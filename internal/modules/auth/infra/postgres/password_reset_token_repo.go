@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/passwordreset"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+type passwordResetTokenRepo struct {
+	*repogen.PgRepo[passwordreset.PasswordResetToken, passwordreset.Filter]
+
+	idb bun.IDB
+}
+
+func NewPasswordResetTokenRepo(idb bun.IDB) passwordreset.Repo {
+	return &passwordResetTokenRepo{
+		PgRepo: repogen.NewPgRepoBuilder[passwordreset.PasswordResetToken, passwordreset.Filter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(passwordreset.CodeTokenNotFound).
+			WithFilterFunc(passwordResetTokenFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *passwordResetTokenRepo) MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error) {
+	res, err := r.idb.NewUpdate().
+		TableExpr("?.password_reset_tokens", bun.Ident(schemaName)).
+		Set("used_at = ?", usedAt).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Where("used_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+	return updated > 0, nil
+}
+
+func passwordResetTokenFilterFunc(q *bun.SelectQuery, f passwordreset.Filter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.AdminID != nil {
+		q = q.Where("admin_id = ?", *f.AdminID)
+	}
+	if f.TokenHash != nil {
+		q = q.Where("token_hash = ?", *f.TokenHash)
+	}
+	if f.IsUsed != nil {
+		if *f.IsUsed {
+			q = q.Where("used_at IS NOT NULL")
+		} else {
+			q = q.Where("used_at IS NULL")
+		}
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+
+	"github.com/code19m/errx"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/uptrace/bun"
+)
+
+// permissionChangesChannel is a Postgres notification channel of permission changes.
+const permissionChangesChannel = "auth_permission_changes"
+
+type permissionChangeNotifier struct {
+	db *bun.DB
+}
+
+// NewPermissionChangeNotifier creates a notifier which delivers changes with Postgres LISTEN/NOTIFY.
+func NewPermissionChangeNotifier(db *bun.DB) rbac.PermissionChangeNotifier {
+	return &permissionChangeNotifier{
+		db,
+	}
+}
+
+func (n *permissionChangeNotifier) Notify(ctx context.Context, change rbac.PermissionChange) error {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	_, err = n.db.ExecContext(ctx, "SELECT pg_notify(?, ?)", permissionChangesChannel, string(payload))
+	return errx.Wrap(err)
+}
+
+func (n *permissionChangeNotifier) Listen(ctx context.Context, handle func(rbac.PermissionChange)) error {
+	// LISTEN is bound to a connection, so a dedicated connection is held while listening
+	conn, err := n.db.Conn(ctx)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errx.New("unexpected database driver connection type")
+		}
+		return n.listen(ctx, stdlibConn.Conn(), handle)
+	})
+	return errx.Wrap(err)
+}
+
+func (n *permissionChangeNotifier) listen(ctx context.Context, conn *pgx.Conn, handle func(rbac.PermissionChange)) error {
+	_, err := conn.Exec(ctx, "LISTEN "+permissionChangesChannel)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	// Stop listening before the connection is returned to the pool
+	defer conn.Exec(context.WithoutCancel(ctx), "UNLISTEN "+permissionChangesChannel) //nolint:errcheck // best-effort
+
+	handle(rbac.PermissionChange{})
+
+	for {
+		var notification *pgconn.Notification
+		notification, err = conn.WaitForNotification(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		var change rbac.PermissionChange
+		err = json.Unmarshal([]byte(notification.Payload), &change)
+		if err != nil {
+			// Unknown payload, treat it as a change of any actor to stay on the safe side
+			change = rbac.PermissionChange{}
+		}
+		handle(change)
+	}
+}
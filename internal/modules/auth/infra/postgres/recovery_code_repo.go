@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+func NewRecoveryCodeRepo(idb bun.IDB) mfa.RecoveryCodeRepo {
+	return repogen.NewPgRepoBuilder[mfa.RecoveryCode, mfa.RecoveryCodeFilter](idb).
+		WithSchemaName(schemaName).
+		WithNotFoundCode(mfa.CodeRecoveryCodeNotFound).
+		WithFilterFunc(recoveryCodeFilterFunc).
+		Build()
+}
+
+func recoveryCodeFilterFunc(q *bun.SelectQuery, f mfa.RecoveryCodeFilter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.AdminID != nil {
+		q = q.Where("admin_id = ?", *f.AdminID)
+	}
+	if f.CodeHash != nil {
+		q = q.Where("code_hash = ?", *f.CodeHash)
+	}
+	if f.IsUsed != nil {
+		if *f.IsUsed {
+			q = q.Where("used_at IS NOT NULL")
+		} else {
+			q = q.Where("used_at IS NULL")
+		}
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
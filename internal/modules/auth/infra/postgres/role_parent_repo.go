@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+type roleParentRepo struct {
+	*repogen.PgRepo[rbac.RoleParent, rbac.RoleParentFilter]
+
+	idb bun.IDB
+}
+
+func NewRoleParentRepo(idb bun.IDB) rbac.RoleParentRepo {
+	return &roleParentRepo{
+		PgRepo: repogen.NewPgRepoBuilder[rbac.RoleParent, rbac.RoleParentFilter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(rbac.CodeRoleParentNotFound).
+			WithConflictCodesMap(map[string]string{
+				"uq_role_parents_role_parent": rbac.CodeRoleParentConflict,
+			}).
+			WithFilterFunc(roleParentFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *roleParentRepo) LockHierarchy(ctx context.Context) error {
+	// EXCLUSIVE mode conflicts with writes and with itself but still allows plain reads
+	_, err := r.idb.ExecContext(ctx, "LOCK TABLE ?.role_parents IN EXCLUSIVE MODE", bun.Ident(schemaName))
+	return errx.Wrap(err)
+}
+
+func roleParentFilterFunc(q *bun.SelectQuery, f rbac.RoleParentFilter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.RoleID != nil {
+		q = q.Where("role_id = ?", *f.RoleID)
+	}
+	if len(f.RoleIDs) > 0 {
+		q = q.Where("role_id IN (?)", bun.In(f.RoleIDs))
+	}
+	if f.ParentRoleID != nil {
+		q = q.Where("parent_role_id = ?", *f.ParentRoleID)
+	}
+	q = q.Order("id ASC")
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
@@ -11,6 +11,9 @@ func NewRolePermissionRepo(idb bun.IDB) rbac.RolePermissionRepo {
 	return repogen.NewPgRepoBuilder[rbac.RolePermission, rbac.RolePermissionFilter](idb).
 		WithSchemaName(schemaName).
 		WithNotFoundCode(rbac.CodeRolePermissionNotFound).
+		WithConflictCodesMap(map[string]string{
+			"uq_role_permissions_role_permission": rbac.CodeRolePermissionConflict,
+		}).
 		WithFilterFunc(rolePermissionFilterFunc).
 		Build()
 }
@@ -22,6 +25,9 @@ func rolePermissionFilterFunc(q *bun.SelectQuery, f rbac.RolePermissionFilter) *
 	if f.RoleID != nil {
 		q = q.Where("role_id = ?", *f.RoleID)
 	}
+	if len(f.RoleIDs) > 0 {
+		q = q.Where("role_id IN (?)", bun.In(f.RoleIDs))
+	}
 	if f.Limit > 0 {
 		q = q.Limit(f.Limit)
 	}
@@ -31,6 +31,7 @@ func roleFilterFunc(q *bun.SelectQuery, f rbac.RoleFilter) *bun.SelectQuery {
 	if len(f.IDs) > 0 {
 		q = q.Where("id IN (?)", bun.In(f.IDs))
 	}
+	q = q.Order("id ASC")
 	if f.Limit > 0 {
 		q = q.Limit(f.Limit)
 	}
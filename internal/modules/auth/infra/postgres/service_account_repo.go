@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+)
+
+func NewServiceAccountRepo(idb bun.IDB) user.ServiceAccountRepo {
+	return repogen.NewPgRepoBuilder[user.ServiceAccount, user.ServiceAccountFilter](idb).
+		WithSchemaName(schemaName).
+		WithNotFoundCode(user.CodeServiceAccountNotFound).
+		WithConflictCodesMap(map[string]string{
+			"service_accounts_name_key": user.CodeServiceAccountNameConflict,
+		}).
+		WithFilterFunc(serviceAccountFilterFunc).
+		Build()
+}
+
+func serviceAccountFilterFunc(q *bun.SelectQuery, f user.ServiceAccountFilter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.Name != nil {
+		q = q.Where("name = ?", *f.Name)
+	}
+	if f.IsActive != nil {
+		q = q.Where("is_active = ?", *f.IsActive)
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
@@ -1,18 +1,123 @@
 package postgres
 
 import (
+	"context"
 	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"time"
 
+	"github.com/code19m/errx"
 	"github.com/rise-and-shine/pkg/repogen"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
+type sessionRepo struct {
+	*repogen.PgRepo[session.Session, session.Filter]
+
+	idb bun.IDB
+}
+
 func NewSessionRepo(idb bun.IDB) session.Repo {
-	return repogen.NewPgRepoBuilder[session.Session, session.Filter](idb).
-		WithSchemaName(schemaName).
-		WithNotFoundCode(session.CodeSessionNotFound).
-		WithFilterFunc(sessionFilterFunc).
-		Build()
+	return &sessionRepo{
+		PgRepo: repogen.NewPgRepoBuilder[session.Session, session.Filter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(session.CodeSessionNotFound).
+			WithFilterFunc(sessionFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *sessionRepo) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error) {
+	batch := r.idb.NewSelect().
+		TableExpr("?.sessions", bun.Ident(schemaName)).
+		Column("id").
+		Where("refresh_token_expires_at < ?", expiredBefore).
+		OrderExpr("refresh_token_expires_at ASC").
+		Limit(limit)
+
+	res, err := r.idb.NewDelete().
+		TableExpr("?.sessions", bun.Ident(schemaName)).
+		Where("id IN (?)", batch).
+		Exec(ctx)
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+	return int(deleted), nil
+}
+
+func (r *sessionRepo) DeleteByActor(
+	ctx context.Context,
+	actorType, actorID string,
+	exceptFamilyID *string,
+) ([]session.Session, error) {
+	var deleted []session.Session
+	q := r.idb.NewDelete().
+		Model(&deleted).
+		ModelTableExpr("?.sessions AS session", bun.Ident(schemaName)).
+		Where("actor_type = ?", actorType).
+		Where("actor_id = ?", actorID)
+	if exceptFamilyID != nil {
+		q = q.Where("family_id <> ?", *exceptFamilyID)
+	}
+
+	_, err := q.Returning("*").Exec(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return deleted, nil
+}
+
+func (r *sessionRepo) MarkRotated(ctx context.Context, id int64, rotatedAt time.Time) (bool, error) {
+	res, err := r.idb.NewUpdate().
+		TableExpr("?.sessions", bun.Ident(schemaName)).
+		Set("rotated_at = ?", rotatedAt).
+		Set("access_token_expires_at = ?", rotatedAt).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Where("rotated_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+	return updated > 0, nil
+}
+
+func (r *sessionRepo) TouchLastUsedAt(ctx context.Context, lastUsedAt map[int64]time.Time) error {
+	if len(lastUsedAt) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(lastUsedAt))
+	times := make([]time.Time, 0, len(lastUsedAt))
+	for id, t := range lastUsedAt {
+		ids = append(ids, id)
+		times = append(times, t)
+	}
+
+	_, err := r.idb.NewUpdate().
+		TableExpr("?.sessions AS s", bun.Ident(schemaName)).
+		TableExpr(
+			"unnest(?::bigint[], ?::timestamptz[]) AS v (id, last_used_at)",
+			pgdialect.Array(ids),
+			pgdialect.Array(times),
+		).
+		Set("last_used_at = v.last_used_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("s.id = v.id").
+		Where("s.last_used_at < v.last_used_at").
+		Exec(ctx)
+	return errx.Wrap(err)
 }
 
 func sessionFilterFunc(q *bun.SelectQuery, f session.Filter) *bun.SelectQuery {
@@ -25,11 +130,21 @@ func sessionFilterFunc(q *bun.SelectQuery, f session.Filter) *bun.SelectQuery {
 	if f.ActorID != nil {
 		q = q.Where("actor_id = ?", *f.ActorID)
 	}
-	if f.AccessToken != nil {
-		q = q.Where("access_token = ?", *f.AccessToken)
+	if f.AccessTokenHash != nil {
+		q = q.Where("access_token_hash = ?", *f.AccessTokenHash)
+	}
+	if f.RefreshTokenHash != nil {
+		q = q.Where("refresh_token_hash = ?", *f.RefreshTokenHash)
+	}
+	if f.FamilyID != nil {
+		q = q.Where("family_id = ?", *f.FamilyID)
 	}
-	if f.RefreshToken != nil {
-		q = q.Where("refresh_token = ?", *f.RefreshToken)
+	if f.IsRotated != nil {
+		if *f.IsRotated {
+			q = q.Where("rotated_at IS NOT NULL")
+		} else {
+			q = q.Where("rotated_at IS NULL")
+		}
 	}
 	if f.Limit > 0 {
 		q = q.Limit(f.Limit)
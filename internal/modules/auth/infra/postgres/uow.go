@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"errors"
 
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/passwordreset"
 	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
 	"go-enterprise-blueprint/internal/modules/auth/domain/session"
 	"go-enterprise-blueprint/internal/modules/auth/domain/uow"
@@ -63,6 +66,10 @@ func (u *pgUOW) RolePermission() rbac.RolePermissionRepo {
 	return NewRolePermissionRepo(u.tx)
 }
 
+func (u *pgUOW) RoleParent() rbac.RoleParentRepo {
+	return NewRoleParentRepo(u.tx)
+}
+
 func (u *pgUOW) ActorRole() rbac.ActorRoleRepo {
 	return NewActorRoleRepo(u.tx)
 }
@@ -78,3 +85,27 @@ func (u *pgUOW) Session() session.Repo {
 func (u *pgUOW) Admin() user.AdminRepo {
 	return NewAdminRepo(u.tx)
 }
+
+func (u *pgUOW) ServiceAccount() user.ServiceAccountRepo {
+	return NewServiceAccountRepo(u.tx)
+}
+
+func (u *pgUOW) APIKey() apikey.Repo {
+	return NewAPIKeyRepo(u.tx)
+}
+
+func (u *pgUOW) User() user.UserRepo {
+	return NewUserRepo(u.tx)
+}
+
+func (u *pgUOW) LoginChallenge() mfa.LoginChallengeRepo {
+	return NewLoginChallengeRepo(u.tx)
+}
+
+func (u *pgUOW) RecoveryCode() mfa.RecoveryCodeRepo {
+	return NewRecoveryCodeRepo(u.tx)
+}
+
+func (u *pgUOW) PasswordResetToken() passwordreset.Repo {
+	return NewPasswordResetTokenRepo(u.tx)
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/repogen"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+type userRepo struct {
+	*repogen.PgRepo[user.User, user.UserFilter]
+
+	idb bun.IDB
+}
+
+func NewUserRepo(idb bun.IDB) user.UserRepo {
+	return &userRepo{
+		PgRepo: repogen.NewPgRepoBuilder[user.User, user.UserFilter](idb).
+			WithSchemaName(schemaName).
+			WithNotFoundCode(user.CodeUserNotFound).
+			WithConflictCodesMap(map[string]string{
+				"users_email_key": user.CodeUserEmailConflict,
+			}).
+			WithFilterFunc(userFilterFunc).
+			Build(),
+		idb: idb,
+	}
+}
+
+func (r *userRepo) TouchLastActiveAt(ctx context.Context, lastActiveAt map[string]time.Time) error {
+	if len(lastActiveAt) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(lastActiveAt))
+	times := make([]time.Time, 0, len(lastActiveAt))
+	for id, t := range lastActiveAt {
+		ids = append(ids, id)
+		times = append(times, t)
+	}
+
+	_, err := r.idb.NewUpdate().
+		TableExpr("?.users AS a", bun.Ident(schemaName)).
+		TableExpr("unnest(?::uuid[], ?::timestamptz[]) AS v (id, last_active_at)", pgdialect.Array(ids), pgdialect.Array(times)).
+		Set("last_active_at = v.last_active_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("a.id = v.id").
+		Where("a.last_active_at IS NULL OR a.last_active_at < v.last_active_at").
+		Exec(ctx)
+	return errx.Wrap(err)
+}
+
+func userFilterFunc(q *bun.SelectQuery, f user.UserFilter) *bun.SelectQuery {
+	if f.ID != nil {
+		q = q.Where("id = ?", *f.ID)
+	}
+	if f.Email != nil {
+		q = q.Where("email = ?", *f.Email)
+	}
+	if f.IsActive != nil {
+		q = q.Where("is_active = ?", *f.IsActive)
+	}
+	if f.Limit > 0 {
+		q = q.Limit(f.Limit)
+	}
+	if f.Offset > 0 {
+		q = q.Offset(f.Offset)
+	}
+	return q
+}
@@ -7,10 +7,62 @@ import (
 	"go-enterprise-blueprint/internal/modules/auth/ctrl/consumer"
 	"go-enterprise-blueprint/internal/modules/auth/ctrl/http"
 	"go-enterprise-blueprint/internal/modules/auth/domain"
+	kafkainfra "go-enterprise-blueprint/internal/modules/auth/infra/kafka"
 	"go-enterprise-blueprint/internal/modules/auth/infra/postgres"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/activity"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/authsession"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/authtoken"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/loginguard"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/passwordpolicy"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permregistry"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permresolver"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/superadmin"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/twofactor"
 	authportal "go-enterprise-blueprint/internal/modules/auth/portal"
 	"go-enterprise-blueprint/internal/modules/auth/usecase"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminchangepassword"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminlogin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminmfaconfirm"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminmfaenroll"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminmfaverify"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminrefreshtoken"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminresetpassword"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createpasswordreset"
 	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createsuperadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/disableadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/getadmins"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/resetadminpassword"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/updateadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/lockout/cleanuploginfailures"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/lockout/unlockaccount"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/cleanupexpiredgrants"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/explainactorpermission"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/getpermissions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setactorpermission"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setactorrole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setroleparents"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setrolepermission"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/createrole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/deleterole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/getroles"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/updaterole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/createapikey"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/createserviceaccount"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/revokeapikey"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/rotateapikey"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/cleanupexpiredsessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/deleteuserallsessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/deleteusersession"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/getmysessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/logoutothersessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/getprofile"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/updateprofile"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userlogin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userlogout"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userrefreshtoken"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userregister"
 	"go-enterprise-blueprint/internal/portal"
 	"go-enterprise-blueprint/internal/portal/auth"
 
@@ -22,7 +74,29 @@ import (
 )
 
 type Config struct {
+	Token authtoken.Config `yaml:"token"`
+
+	TwoFactor twofactor.Config `yaml:"two_factor"`
+
+	Lockout loginguard.Config `yaml:"lockout"`
+
+	PasswordPolicy passwordpolicy.Config `yaml:"password_policy"`
+
+	Activity activity.Config `yaml:"activity"`
+
+	Permissions permresolver.Config `yaml:"permissions"`
+
+	SessionCleanup cleanupexpiredsessions.Config `yaml:"session_cleanup"`
+
+	GrantCleanup cleanupexpiredgrants.Config `yaml:"grant_cleanup"`
+
+	LoginFailureCleanup cleanuploginfailures.Config `yaml:"login_failure_cleanup"`
+
+	Events kafkainfra.Config `yaml:"events"`
+
 	Consumers consumer.Config `yaml:"consumers"`
+
+	AsyncTasks asynctask.Config `yaml:"async_tasks"`
 }
 
 type Module struct {
@@ -31,6 +105,12 @@ type Module struct {
 	cliCTRL       *cli.Controller
 	httpCTRL      *http.Controller
 
+	// Components with background work, they run along with controllers.
+	activityTracker    *activity.Tracker
+	permissionResolver *permresolver.Resolver
+
+	grantEventPublisher *kafkainfra.GrantEventPublisher
+
 	portal auth.Portal
 }
 
@@ -50,29 +130,125 @@ func New(
 		m   = &Module{}
 	)
 
+	// Init event publishers
+	m.grantEventPublisher = kafkainfra.NewGrantEventPublisher(cfg.Events, brokerConfig)
+
 	// Init repositories
 	domainContainer := domain.NewContainer(
 		postgres.NewAdminRepo(dbConn),
 		postgres.NewSessionRepo(dbConn),
 		postgres.NewRoleRepo(dbConn),
 		postgres.NewRolePermissionRepo(dbConn),
+		postgres.NewRoleParentRepo(dbConn),
 		postgres.NewActorRoleRepo(dbConn),
 		postgres.NewActorPermissionRepo(dbConn),
+		postgres.NewServiceAccountRepo(dbConn),
+		postgres.NewAPIKeyRepo(dbConn),
+		postgres.NewUserRepo(dbConn),
+		postgres.NewLoginChallengeRepo(dbConn),
+		postgres.NewRecoveryCodeRepo(dbConn),
+		postgres.NewLoginFailureRepo(dbConn),
+		postgres.NewPasswordResetTokenRepo(dbConn),
 		postgres.NewUOWFactory(dbConn),
+		postgres.NewPermissionChangeNotifier(dbConn),
+		m.grantEventPublisher,
+	)
+
+	// Init packaged business logic components
+	tokenManager, err := authtoken.NewManager(cfg.Token)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	twoFactorManager, err := twofactor.NewManager(cfg.TwoFactor)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	passwordPolicy, err := passwordpolicy.New(cfg.PasswordPolicy)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	m.permissionResolver, err = permresolver.New(cfg.Permissions, domainContainer)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	m.activityTracker = activity.New(cfg.Activity, domainContainer)
+	pblcContainer := pblc.NewContainer(
+		tokenManager,
+		m.permissionResolver,
+		permregistry.New(),
+		superadmin.New(domainContainer),
+		twoFactorManager,
+		loginguard.New(cfg.Lockout, domainContainer),
+		passwordPolicy,
+		m.activityTracker,
+		authsession.New(domainContainer, tokenManager, m.activityTracker),
 	)
 
 	// Init use cases
 	usecaseContainer := usecase.NewContainer(
-		createsuperadmin.New(domainContainer),
+		createsuperadmin.New(domainContainer, pblcContainer),
+		adminlogin.New(domainContainer, pblcContainer),
+		adminrefreshtoken.New(domainContainer, pblcContainer),
+		createadmin.New(domainContainer, pblcContainer),
+		updateadmin.New(domainContainer, pblcContainer),
+		disableadmin.New(domainContainer, pblcContainer),
+		getadmins.New(domainContainer, pblcContainer),
+		adminmfaenroll.New(domainContainer, pblcContainer),
+		adminmfaconfirm.New(domainContainer, pblcContainer),
+		adminmfaverify.New(domainContainer, pblcContainer),
+		unlockaccount.New(domainContainer),
+		cleanuploginfailures.New(cfg.LoginFailureCleanup, domainContainer, pblcContainer),
+
+		adminchangepassword.New(domainContainer, pblcContainer),
+		createpasswordreset.New(domainContainer, pblcContainer),
+		adminresetpassword.New(domainContainer, pblcContainer),
+		resetadminpassword.New(domainContainer, pblcContainer),
+
+		getpermissions.New(pblcContainer),
+		setrolepermission.New(domainContainer, pblcContainer),
+		setactorpermission.New(domainContainer, pblcContainer),
+		setactorrole.New(domainContainer, pblcContainer),
+		setroleparents.New(domainContainer, pblcContainer),
+		explainactorpermission.New(domainContainer, pblcContainer),
+		cleanupexpiredgrants.New(cfg.GrantCleanup, domainContainer, pblcContainer),
+
+		createrole.New(domainContainer),
+		updaterole.New(domainContainer),
+		deleterole.New(domainContainer, pblcContainer),
+		getroles.New(domainContainer),
+
+		createserviceaccount.New(domainContainer),
+		createapikey.New(domainContainer, pblcContainer),
+		rotateapikey.New(domainContainer, pblcContainer),
+		revokeapikey.New(domainContainer),
+
+		deleteusersession.New(domainContainer, pblcContainer),
+		deleteuserallsessions.New(domainContainer),
+		getmysessions.New(domainContainer),
+		logoutothersessions.New(domainContainer),
+		cleanupexpiredsessions.New(cfg.SessionCleanup, domainContainer),
+
+		userregister.New(domainContainer, pblcContainer),
+		userlogin.New(domainContainer, pblcContainer),
+		userrefreshtoken.New(domainContainer, pblcContainer),
+		userlogout.New(domainContainer),
+		getprofile.New(domainContainer),
+		updateprofile.New(domainContainer),
 	)
 
 	// Init portal
-	m.portal = authportal.New()
+	m.portal = authportal.New(domainContainer, pblcContainer)
+
+	// Register permissions declared by auth module
+	err = m.portal.RegisterPermissions(m.name(), auth.Permissions())
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
 
 	// Init controllers
 	m.cliCTRL = cli.NewController(usecaseContainer)
 	m.httpCTRL = http.NewContoller(usecaseContainer, portalContainer, httpServer)
-	m.asynctaskCTRL, err = asynctask.NewController(dbConn, m.name(), usecaseContainer)
+	m.asynctaskCTRL, err = asynctask.NewController(cfg.AsyncTasks, dbConn, m.name(), usecaseContainer)
 	if err != nil {
 		return nil, errx.Wrap(err)
 	}
@@ -95,21 +271,39 @@ func (m *Module) Start() error {
 
 	g.Go(m.consumerCTRL.Start)
 
+	g.Go(m.activityTracker.Start)
+
+	g.Go(m.permissionResolver.Start)
+
 	return errx.Wrap(g.Wait())
 }
 
 func (m *Module) Shutdown() error {
-	errs := make(chan error, 2) // buffer size == controller count
+	errs := make(chan error, 4) // buffer size == controller count
 
 	go func() { errs <- m.asynctaskCTRL.Shutdown() }()
 
 	go func() { errs <- m.consumerCTRL.Shutdown() }()
 
-	return errx.Wrap(errors.Join(<-errs, <-errs)) // <-errs count == controller count
+	go func() { errs <- m.activityTracker.Stop() }()
+
+	go func() { errs <- m.permissionResolver.Stop() }()
+
+	err := errors.Join(<-errs, <-errs, <-errs, <-errs) // <-errs count == controller count
+
+	// Publishers are closed after controllers, which may still publish while stopping
+	return errx.Wrap(errors.Join(err, m.grantEventPublisher.Close()))
 }
 
 // --- CLI commands of auth module ---
 
-func (m *Module) CreateSuperadmin() error {
-	return errx.Wrap(m.cliCTRL.CreateSuperadminCmd())
+// CreateSuperadminOptions are flags of create-superadmin command.
+type CreateSuperadminOptions = cli.CreateSuperadminOptions
+
+func (m *Module) CreateSuperadmin(opts CreateSuperadminOptions) error {
+	return errx.Wrap(m.cliCTRL.CreateSuperadminCmd(opts))
+}
+
+func (m *Module) ResetAdminPassword(username string, resetMFA bool) error {
+	return errx.Wrap(m.cliCTRL.ResetAdminPasswordCmd(username, resetMFA))
 }
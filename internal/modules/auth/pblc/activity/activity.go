@@ -0,0 +1,143 @@
+// Package activity tracks usage of sessions and activity of their actors.
+// Activity is recorded in memory at most once per session per update interval
+// and written to the database in batches in the background,
+// so tracking doesn't add a database write to every authenticated request.
+package activity
+
+import (
+	"context"
+	"errors"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"sync"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"github.com/rise-and-shine/pkg/pg/hooks"
+)
+
+const flushTimeout = 30 * time.Second
+
+type Config struct {
+	// UpdateInterval is a minimum period between two recorded usages of a session. Default is 1 minute.
+	UpdateInterval time.Duration `yaml:"update_interval" validate:"required" default:"1m"`
+
+	// FlushInterval is a period of writing recorded activity to the database. Default is 10 seconds.
+	FlushInterval time.Duration `yaml:"flush_interval" validate:"required" default:"10s"`
+
+	// IdleTimeout expires sessions which are not used for longer than this period.
+	// Must be considerably longer than UpdateInterval + FlushInterval. Zero disables the timeout, default is zero.
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+}
+
+type Tracker struct {
+	cfg             Config
+	domainContainer *domain.Container
+
+	mu       sync.Mutex
+	sessions map[int64]time.Time
+	admins   map[string]time.Time
+	users    map[string]time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func New(cfg Config, domainContainer *domain.Container) *Tracker {
+	return &Tracker{
+		cfg:             cfg,
+		domainContainer: domainContainer,
+		sessions:        make(map[int64]time.Time),
+		admins:          make(map[string]time.Time),
+		users:           make(map[string]time.Time),
+		stop:            make(chan struct{}),
+	}
+}
+
+// Touch records a usage of the session by its actor. The usage is skipped
+// if the session was used less than the update interval ago.
+func (t *Tracker) Touch(sess *session.Session) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.lastUsedAt(sess)) < t.cfg.UpdateInterval {
+		return
+	}
+
+	t.sessions[sess.ID] = now
+	switch rbac.ActorType(sess.ActorType) {
+	case rbac.ActorTypeAdmin:
+		t.admins[sess.ActorID] = now
+	case rbac.ActorTypeUser:
+		t.users[sess.ActorID] = now
+	}
+}
+
+// IsIdle reports whether the session is not used for longer than the idle timeout.
+// Usages recorded but not yet written to the database are considered.
+func (t *Tracker) IsIdle(sess *session.Session) bool {
+	if t.cfg.IdleTimeout <= 0 {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return time.Since(t.lastUsedAt(sess)) > t.cfg.IdleTimeout
+}
+
+// Start writes recorded activity to the database every flush interval until Stop is called.
+func (t *Tracker) Start() error {
+	ticker := time.NewTicker(t.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := t.flush()
+			if err != nil {
+				logger.With("module", "auth").Errorx(err)
+			}
+		case <-t.stop:
+			return nil
+		}
+	}
+}
+
+// Stop stops the background writing and writes the remaining recorded activity.
+func (t *Tracker) Stop() error {
+	t.stopOnce.Do(func() { close(t.stop) })
+	return errx.Wrap(t.flush())
+}
+
+// lastUsedAt returns the latest usage time of the session. Must be called with mu held.
+func (t *Tracker) lastUsedAt(sess *session.Session) time.Time {
+	if recorded, ok := t.sessions[sess.ID]; ok && recorded.After(sess.LastUsedAt) {
+		return recorded
+	}
+	return sess.LastUsedAt
+}
+
+// flush writes recorded activity to the database. Activity of a failed write is dropped,
+// since tracking is best-effort and the next usage is recorded again.
+func (t *Tracker) flush() error {
+	t.mu.Lock()
+	sessions, admins, users := t.sessions, t.admins, t.users
+	t.sessions = make(map[int64]time.Time)
+	t.admins = make(map[string]time.Time)
+	t.users = make(map[string]time.Time)
+	t.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(hooks.WithSuppressedQueryLogs(context.Background()), flushTimeout)
+	defer cancel()
+
+	return errx.Wrap(errors.Join(
+		t.domainContainer.SessionRepo().TouchLastUsedAt(ctx, sessions),
+		t.domainContainer.AdminRepo().TouchLastActiveAt(ctx, admins),
+		t.domainContainer.UserRepo().TouchLastActiveAt(ctx, users),
+	))
+}
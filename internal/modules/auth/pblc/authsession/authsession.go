@@ -0,0 +1,215 @@
+// Package authsession starts login sessions and rotates them by refresh tokens, the same way for every actor type.
+// Each login starts a new session family, a refresh replaces the session with the next generation of its family,
+// and presenting a refresh token of an already rotated session revokes the whole family.
+package authsession
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/modules/auth/domain/uow"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/activity"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/authtoken"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/google/uuid"
+	"github.com/rise-and-shine/pkg/meta"
+	"github.com/rise-and-shine/pkg/observability/logger"
+)
+
+// ActiveCheck returns an error if the actor of a refreshed session can no longer be signed in.
+type ActiveCheck func(ctx context.Context, actorID string) error
+
+type Manager struct {
+	domainContainer *domain.Container
+	tokenManager    *authtoken.Manager
+	activityTracker *activity.Tracker
+}
+
+func New(
+	domainContainer *domain.Container,
+	tokenManager *authtoken.Manager,
+	activityTracker *activity.Tracker,
+) *Manager {
+	return &Manager{
+		domainContainer,
+		tokenManager,
+		activityTracker,
+	}
+}
+
+// Start issues tokens and creates the first session of a new family within the given unit of work.
+func (m *Manager) Start(
+	ctx context.Context,
+	unit uow.UnitOfWork,
+	actorType rbac.ActorType,
+	actorID string,
+	now time.Time,
+) (*authtoken.Tokens, error) {
+	tokens, err := m.tokenManager.Issue(string(actorType), actorID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	_, err = unit.Session().Create(ctx, &session.Session{
+		ActorType:             string(actorType),
+		ActorID:               actorID,
+		AccessTokenHash:       tokens.AccessTokenHash,
+		AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+		RefreshTokenHash:      tokens.RefreshTokenHash,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		FamilyID:              uuid.NewString(),
+		Generation:            1,
+		IPAddress:             meta.Find(ctx, meta.IPAddress),
+		UserAgent:             meta.Find(ctx, meta.UserAgent),
+		LastUsedAt:            now,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return tokens, nil
+}
+
+// Refresh exchanges the refresh token of an actor's session for new tokens and rotates the session.
+// The session's actor is checked with isActive before the rotation.
+func (m *Manager) Refresh(
+	ctx context.Context,
+	actorType rbac.ActorType,
+	refreshToken string,
+	isActive ActiveCheck,
+) (*authtoken.Tokens, error) {
+	// Find session by refresh token
+	sessActorType := string(actorType)
+	refreshTokenHash := m.tokenManager.HashSessionToken(refreshToken)
+	sess, err := m.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ActorType:        &sessActorType,
+		RefreshTokenHash: &refreshTokenHash,
+	})
+	if errx.IsCodeIn(err, session.CodeSessionNotFound) {
+		return nil, errx.New(
+			"refresh token is invalid",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(session.CodeInvalidRefreshToken),
+		)
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Detect reuse of an already rotated refresh token
+	if sess.RotatedAt != nil {
+		return nil, m.revokeFamily(ctx, sess)
+	}
+
+	// Check if refresh token is not expired
+	if time.Now().After(sess.RefreshTokenExpiresAt) {
+		return nil, errx.New(
+			"refresh token is expired",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(session.CodeRefreshTokenExpired),
+		)
+	}
+
+	// Check if session is not expired due to inactivity
+	if m.activityTracker.IsIdle(sess) {
+		return nil, errx.New(
+			"session is expired due to inactivity",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(session.CodeSessionIdleTimeout),
+		)
+	}
+
+	// Check if session's actor is still active
+	err = isActive(ctx, sess.ActorID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Generate new access and refresh tokens
+	tokens, err := m.tokenManager.Issue(sess.ActorType, sess.ActorID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Start UOW
+	unit, err := m.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer unit.DiscardUnapplied()
+
+	// Mark current session as rotated and expire its access token,
+	// a concurrent request which already rotated it is a reuse of the refresh token
+	now := time.Now()
+	rotated, err := unit.Session().MarkRotated(ctx, sess.ID, now)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !rotated {
+		unit.DiscardUnapplied()
+		return nil, m.revokeFamily(ctx, sess)
+	}
+
+	// Create the next session of the family
+	_, err = unit.Session().Create(ctx, &session.Session{
+		ActorType:             sess.ActorType,
+		ActorID:               sess.ActorID,
+		AccessTokenHash:       tokens.AccessTokenHash,
+		AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+		RefreshTokenHash:      tokens.RefreshTokenHash,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		FamilyID:              sess.FamilyID,
+		Generation:            sess.Generation + 1,
+		IPAddress:             meta.Find(ctx, meta.IPAddress),
+		UserAgent:             meta.Find(ctx, meta.UserAgent),
+		LastUsedAt:            now,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = unit.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return tokens, nil
+}
+
+// revokeFamily deletes every session of the rotated session's family
+// and returns an error that reports the reuse to the caller.
+func (m *Manager) revokeFamily(ctx context.Context, rotated *session.Session) error {
+	reuseErr := errx.New(
+		"refresh token was already used, all sessions of this login are revoked",
+		errx.WithType(errx.T_Authentication),
+		errx.WithCode(session.CodeRefreshTokenReused),
+		errx.WithDetails(errx.D{
+			"family_id":  rotated.FamilyID,
+			"generation": rotated.Generation,
+		}),
+	)
+
+	family, err := m.domainContainer.SessionRepo().List(ctx, session.Filter{
+		ActorType: &rotated.ActorType,
+		ActorID:   &rotated.ActorID,
+		FamilyID:  &rotated.FamilyID,
+	})
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	err = m.domainContainer.SessionRepo().BulkDelete(ctx, family)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	logger.
+		WithContext(ctx).
+		With("actor_type", rotated.ActorType, "actor_id", rotated.ActorID, "family_id", rotated.FamilyID).
+		Warn("refresh token reuse detected, session family revoked")
+
+	return reuseErr
+}
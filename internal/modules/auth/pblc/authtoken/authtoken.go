@@ -0,0 +1,179 @@
+// Package authtoken issues and verifies tokens of auth sessions.
+package authtoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/token"
+)
+
+const (
+	claimActorType = "actor_type"
+
+	apiKeyPrefix    = "sk_"
+	apiKeyPrefixLen = len(apiKeyPrefix) + 8
+)
+
+type Config struct {
+	// AccessTokenSecret is a secret key used to sign JWT access tokens. Must be at least 16 characters.
+	AccessTokenSecret string `yaml:"access_token_secret" validate:"required,min=16"`
+
+	// SessionTokenHashSecret is a secret key used to hash access and refresh tokens of sessions
+	// with HMAC-SHA256 before they are stored. Must be at least 32 characters.
+	// Changing it invalidates all existing sessions.
+	SessionTokenHashSecret string `yaml:"session_token_hash_secret" validate:"required,min=32"`
+
+	// AccessTokenTTL is a lifetime of access tokens. Default is 1 hour.
+	AccessTokenTTL time.Duration `yaml:"access_token_ttl" validate:"required" default:"1h"`
+
+	// RefreshTokenTTL is a lifetime of refresh tokens. Default is 7 days.
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" validate:"required" default:"168h"`
+
+	// PasswordResetTokenTTL is a lifetime of password reset tokens. Default is 1 hour.
+	PasswordResetTokenTTL time.Duration `yaml:"password_reset_token_ttl" validate:"required" default:"1h"`
+}
+
+// Tokens is a pair of access and refresh tokens issued for a session.
+// Plain tokens must be returned to the caller once, only their hashes are stored.
+type Tokens struct {
+	AccessToken           string
+	AccessTokenHash       string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenHash      string
+	RefreshTokenExpiresAt time.Time
+}
+
+// APIKey is a newly issued API key of a service account.
+type APIKey struct {
+	// Key is the plain key, it must be shown to the caller once and never stored.
+	Key string
+	// Prefix is a first few characters of the key, safe to store and display.
+	Prefix string
+	// Hash is a hash of the key used to store and look it up.
+	Hash string
+}
+
+// PasswordResetToken is a newly issued one-time password reset token.
+type PasswordResetToken struct {
+	// Token is the plain token, it must be shown to the caller once and never stored.
+	Token string
+	// Hash is a hash of the token used to store and look it up.
+	Hash      string
+	ExpiresAt time.Time
+}
+
+// Claims are the verified claims of an access token.
+type Claims struct {
+	ActorType string
+	ActorID   string
+	ExpiresAt time.Time
+}
+
+// Manager issues signed JWT access tokens and opaque refresh tokens.
+type Manager struct {
+	cfg      Config
+	jwtMaker *token.JWTMaker
+}
+
+func NewManager(cfg Config) (*Manager, error) {
+	jwtMaker, err := token.NewJWTMaker(cfg.AccessTokenSecret)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Manager{
+		cfg,
+		jwtMaker,
+	}, nil
+}
+
+// Issue creates a new pair of tokens for the given actor.
+func (m *Manager) Issue(actorType, actorID string) (*Tokens, error) {
+	accessToken, payload, err := m.jwtMaker.CreateToken(
+		actorID,
+		m.cfg.AccessTokenTTL,
+		map[string]any{claimActorType: actorType},
+	)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	refreshToken := token.NewOpaqueToken()
+
+	return &Tokens{
+		AccessToken:           accessToken,
+		AccessTokenHash:       m.HashSessionToken(accessToken),
+		AccessTokenExpiresAt:  payload.ExpiresAt.Time,
+		RefreshToken:          refreshToken,
+		RefreshTokenHash:      m.HashSessionToken(refreshToken),
+		RefreshTokenExpiresAt: time.Now().Add(m.cfg.RefreshTokenTTL),
+	}, nil
+}
+
+// HashSessionToken returns a keyed hash of the plain access or refresh token as it is stored in the database,
+// so a leaked database doesn't expose usable tokens without the hash secret.
+func (m *Manager) HashSessionToken(sessionToken string) string {
+	mac := hmac.New(sha256.New, []byte(m.cfg.SessionTokenHashSecret))
+	mac.Write([]byte(sessionToken))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyAccessToken checks the signature and expiration of the access token and returns its claims.
+// Returns token.CodeInvalidToken or token.CodeExpiredToken coded errors on failure.
+func (m *Manager) VerifyAccessToken(accessToken string) (*Claims, error) {
+	payload, err := m.jwtMaker.VerifyToken(accessToken)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	actorType, ok := payload.CustomClaims[claimActorType].(string)
+	if !ok || actorType == "" {
+		return nil, errx.New("access token has no actor type claim", errx.WithCode(token.CodeInvalidToken))
+	}
+
+	return &Claims{
+		ActorType: actorType,
+		ActorID:   payload.Subject,
+		ExpiresAt: payload.ExpiresAt.Time,
+	}, nil
+}
+
+// IssueAPIKey creates a new random API key.
+func (m *Manager) IssueAPIKey() *APIKey {
+	key := apiKeyPrefix + token.NewOpaqueToken()
+
+	return &APIKey{
+		Key:    key,
+		Prefix: key[:apiKeyPrefixLen],
+		Hash:   m.HashAPIKey(key),
+	}
+}
+
+// HashAPIKey returns a hash of the plain API key as it is stored in the database.
+// API keys are high entropy random strings, so a fast hash is enough to protect them at rest.
+func (m *Manager) HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IssuePasswordResetToken creates a new random password reset token.
+func (m *Manager) IssuePasswordResetToken() *PasswordResetToken {
+	t := token.NewOpaqueToken()
+
+	return &PasswordResetToken{
+		Token:     t,
+		Hash:      m.HashPasswordResetToken(t),
+		ExpiresAt: time.Now().Add(m.cfg.PasswordResetTokenTTL),
+	}
+}
+
+// HashPasswordResetToken returns a hash of the plain password reset token as it is stored in the database.
+func (m *Manager) HashPasswordResetToken(resetToken string) string {
+	sum := sha256.Sum256([]byte(resetToken))
+	return hex.EncodeToString(sum[:])
+}
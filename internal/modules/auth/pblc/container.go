@@ -0,0 +1,87 @@
+package pblc
+
+import (
+	"go-enterprise-blueprint/internal/modules/auth/pblc/activity"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/authsession"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/authtoken"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/loginguard"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/passwordpolicy"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permregistry"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permresolver"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/superadmin"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/twofactor"
+)
+
+// Container holds packaged business logic components of the auth module.
+// It acts as a dependency injection container for the PBLC layer.
+type Container struct {
+	tokenManager       *authtoken.Manager
+	permissionResolver *permresolver.Resolver
+	permissionRegistry *permregistry.Registry
+	superadminChecker  *superadmin.Checker
+	twoFactorManager   *twofactor.Manager
+	loginGuard         *loginguard.Guard
+	passwordPolicy     *passwordpolicy.Policy
+	activityTracker    *activity.Tracker
+	sessionManager     *authsession.Manager
+}
+
+func NewContainer(
+	tokenManager *authtoken.Manager,
+	permissionResolver *permresolver.Resolver,
+	permissionRegistry *permregistry.Registry,
+	superadminChecker *superadmin.Checker,
+	twoFactorManager *twofactor.Manager,
+	loginGuard *loginguard.Guard,
+	passwordPolicy *passwordpolicy.Policy,
+	activityTracker *activity.Tracker,
+	sessionManager *authsession.Manager,
+) *Container {
+	return &Container{
+		tokenManager,
+		permissionResolver,
+		permissionRegistry,
+		superadminChecker,
+		twoFactorManager,
+		loginGuard,
+		passwordPolicy,
+		activityTracker,
+		sessionManager,
+	}
+}
+
+func (c *Container) TokenManager() *authtoken.Manager {
+	return c.tokenManager
+}
+
+func (c *Container) PermissionResolver() *permresolver.Resolver {
+	return c.permissionResolver
+}
+
+func (c *Container) PermissionRegistry() *permregistry.Registry {
+	return c.permissionRegistry
+}
+
+func (c *Container) SuperadminChecker() *superadmin.Checker {
+	return c.superadminChecker
+}
+
+func (c *Container) TwoFactorManager() *twofactor.Manager {
+	return c.twoFactorManager
+}
+
+func (c *Container) LoginGuard() *loginguard.Guard {
+	return c.loginGuard
+}
+
+func (c *Container) PasswordPolicy() *passwordpolicy.Policy {
+	return c.passwordPolicy
+}
+
+func (c *Container) ActivityTracker() *activity.Tracker {
+	return c.activityTracker
+}
+
+func (c *Container) SessionManager() *authsession.Manager {
+	return c.sessionManager
+}
@@ -0,0 +1,211 @@
+// Package loginguard protects logins against password guessing.
+// It counts failed attempts per login identifier and per client IP address,
+// rejects attempts made too soon after a failure and temporarily locks out subjects with too many failures.
+// Counters are stored in the database, so limits are shared by all running instances.
+package loginguard
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/lockout"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"math"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/meta"
+)
+
+type Config struct {
+	// MaxFailedAttempts is a number of failures of a login identifier after which it's locked out. Default is 5.
+	MaxFailedAttempts int `yaml:"max_failed_attempts" validate:"required,min=1" default:"5"`
+
+	// MaxFailedAttemptsPerIP is a number of failures from a client IP address after which it's locked out. Default is 20.
+	MaxFailedAttemptsPerIP int `yaml:"max_failed_attempts_per_ip" validate:"required,min=1" default:"20"`
+
+	// FailureWindow is a period after the last failure when failures are forgotten. Default is 15 minutes.
+	FailureWindow time.Duration `yaml:"failure_window" validate:"required" default:"15m"`
+
+	// LockoutDuration is a lifetime of a lockout. Default is 15 minutes.
+	LockoutDuration time.Duration `yaml:"lockout_duration" validate:"required" default:"15m"`
+
+	// BaseDelay is a delay required after the second consecutive failure, it doubles with every next failure.
+	// Default is 1 second.
+	BaseDelay time.Duration `yaml:"base_delay" validate:"required" default:"1s"`
+
+	// MaxDelay caps the progressive delay. Default is 30 seconds.
+	MaxDelay time.Duration `yaml:"max_delay" validate:"required" default:"30s"`
+}
+
+type Guard struct {
+	cfg             Config
+	domainContainer *domain.Container
+}
+
+func New(cfg Config, domainContainer *domain.Container) *Guard {
+	return &Guard{
+		cfg,
+		domainContainer,
+	}
+}
+
+// Check returns an error if a login attempt of the identifier from the client IP address
+// in the context must be rejected without checking credentials.
+func (g *Guard) Check(ctx context.Context, actorType rbac.ActorType, identifier string) error {
+	now := time.Now()
+
+	for _, s := range g.subjects(ctx, identifier) {
+		failure, err := g.find(ctx, actorType, s)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+		if failure == nil {
+			continue
+		}
+
+		// A lockout lasts its whole duration, even if it's longer than the failure window
+		if failure.IsLocked(now) {
+			return lockedError(s.scope, *failure.LockedUntil, now)
+		}
+		if failure.LastFailedAt.Before(g.ForgottenBefore(now)) {
+			continue
+		}
+
+		retryAt := failure.LastFailedAt.Add(g.delay(failure.FailedAttempts))
+		if now.Before(retryAt) {
+			return errx.New(
+				"too many failed login attempts, retry later",
+				errx.WithType(errx.T_Throttling),
+				errx.WithCode(lockout.CodeLoginThrottled),
+				errx.WithDetails(errx.D{
+					"retry_after_seconds": retryAfterSeconds(retryAt, now),
+				}),
+			)
+		}
+	}
+	return nil
+}
+
+// RegisterFailure counts a failed login attempt of the identifier from the client IP address in the context
+// and locks out the subjects which reached the limit.
+func (g *Guard) RegisterFailure(ctx context.Context, actorType rbac.ActorType, identifier string) error {
+	now := time.Now()
+
+	for _, s := range g.subjects(ctx, identifier) {
+		failure, err := g.domainContainer.LoginFailureRepo().Increment(ctx, &lockout.LoginFailure{
+			ActorType:    string(actorType),
+			Scope:        string(s.scope),
+			Subject:      s.subject,
+			LastFailedAt: now,
+		}, g.ForgottenBefore(now))
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		if failure.FailedAttempts < g.maxFailedAttempts(s.scope) || failure.IsLocked(now) {
+			continue
+		}
+
+		lockedUntil := now.Add(g.cfg.LockoutDuration)
+		failure.LockedUntil = &lockedUntil
+		_, err = g.domainContainer.LoginFailureRepo().Update(ctx, failure)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// RegisterSuccess forgets failures of the identifier after a successful login.
+// Failures of the client IP address are kept, otherwise an attacker could reset them with an own account.
+func (g *Guard) RegisterSuccess(ctx context.Context, actorType rbac.ActorType, identifier string) error {
+	failure, err := g.find(ctx, actorType, subject{lockout.ScopeUsername, identifier})
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	if failure == nil {
+		return nil
+	}
+
+	err = g.domainContainer.LoginFailureRepo().Delete(ctx, failure)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	return nil
+}
+
+// ForgottenBefore returns the time failures before which are forgotten at the given time,
+// counters not locked out whose last failure is before it start over.
+func (g *Guard) ForgottenBefore(now time.Time) time.Time {
+	return now.Add(-g.cfg.FailureWindow)
+}
+
+type subject struct {
+	scope   lockout.Scope
+	subject string
+}
+
+func (g *Guard) subjects(ctx context.Context, identifier string) []subject {
+	subjects := []subject{{lockout.ScopeUsername, identifier}}
+
+	ip := meta.Find(ctx, meta.IPAddress)
+	if ip != "" {
+		subjects = append(subjects, subject{lockout.ScopeIPAddress, ip})
+	}
+	return subjects
+}
+
+func (g *Guard) find(ctx context.Context, actorType rbac.ActorType, s subject) (*lockout.LoginFailure, error) {
+	at := string(actorType)
+	scope := string(s.scope)
+	failure, err := g.domainContainer.LoginFailureRepo().FirstOrNil(ctx, lockout.Filter{
+		ActorType: &at,
+		Scope:     &scope,
+		Subject:   &s.subject,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return failure, nil
+}
+
+func (g *Guard) maxFailedAttempts(scope lockout.Scope) int {
+	if scope == lockout.ScopeIPAddress {
+		return g.cfg.MaxFailedAttemptsPerIP
+	}
+	return g.cfg.MaxFailedAttempts
+}
+
+// delay returns a delay required after the given number of consecutive failures.
+// The first failure doesn't require a delay, so a single typo doesn't slow down a legitimate user.
+func (g *Guard) delay(failedAttempts int) time.Duration {
+	if failedAttempts < 2 {
+		return 0
+	}
+	d := float64(g.cfg.BaseDelay) * math.Pow(2, float64(failedAttempts-2))
+	if d > float64(g.cfg.MaxDelay) {
+		return g.cfg.MaxDelay
+	}
+	return time.Duration(d)
+}
+
+func lockedError(scope lockout.Scope, lockedUntil, now time.Time) error {
+	msg := "account is temporarily locked due to too many failed login attempts"
+	if scope == lockout.ScopeIPAddress {
+		msg = "too many failed login attempts from this IP address, retry later"
+	}
+	return errx.New(
+		msg,
+		errx.WithType(errx.T_Forbidden),
+		errx.WithCode(lockout.CodeAccountLocked),
+		errx.WithDetails(errx.D{
+			"scope":               scope,
+			"locked_until":        lockedUntil,
+			"retry_after_seconds": retryAfterSeconds(lockedUntil, now),
+		}),
+	)
+}
+
+func retryAfterSeconds(at, now time.Time) int {
+	return int(math.Ceil(at.Sub(now).Seconds()))
+}
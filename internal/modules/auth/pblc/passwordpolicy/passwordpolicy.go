@@ -0,0 +1,175 @@
+// Package passwordpolicy checks passwords against the configured password policy.
+package passwordpolicy
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/code19m/errx"
+)
+
+const (
+	CodeWeakPassword = "WEAK_PASSWORD"
+
+	// maxLength is a hard limit of password length, bcrypt ignores bytes after the 72nd one.
+	maxLength = 72
+)
+
+// Character classes which can be required by the policy.
+const (
+	CharClassLowercase = "lowercase"
+	CharClassUppercase = "uppercase"
+	CharClassDigit     = "digit"
+	CharClassSymbol    = "symbol"
+)
+
+// Rules reported in violations.
+const (
+	RuleMinLength      = "min_length"
+	RuleMaxLength      = "max_length"
+	RuleCharClass      = "char_class"
+	RuleCommonPassword = "common_password"
+	RuleUsername       = "not_username"
+)
+
+//go:embed common_passwords.txt
+var commonPasswords []byte
+
+type Config struct {
+	// MinLength is a minimal number of characters in a password. Default is 8.
+	MinLength int `yaml:"min_length" validate:"required,min=1,max=72" default:"8"`
+
+	// RequiredCharClasses is a list of character classes each password must contain:
+	// lowercase, uppercase, digit, symbol. Default is lowercase, uppercase and digit.
+	RequiredCharClasses []string `yaml:"required_char_classes" validate:"dive,oneof=lowercase uppercase digit symbol" default:"[\"lowercase\",\"uppercase\",\"digit\"]"`
+
+	// DenyListFile is an optional path to a file with additional denied passwords, one per line.
+	// It extends the built-in list of common passwords.
+	DenyListFile string `yaml:"deny_list_file"`
+}
+
+// Violation is a single rule of the policy which a password doesn't satisfy.
+type Violation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+type Policy struct {
+	cfg      Config
+	denyList map[string]struct{}
+}
+
+func New(cfg Config) (*Policy, error) {
+	denyList := make(map[string]struct{})
+	addDenied(denyList, commonPasswords)
+
+	if cfg.DenyListFile != "" {
+		data, err := os.ReadFile(cfg.DenyListFile)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		addDenied(denyList, data)
+	}
+
+	return &Policy{
+		cfg,
+		denyList,
+	}, nil
+}
+
+// Validate checks the password of the account with the given username (or email).
+// Returns CodeWeakPassword coded validation error listing every violated rule.
+func (p *Policy) Validate(password, username string) error {
+	violations := p.Violations(password, username)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return errx.New(
+		"password does not satisfy the password policy",
+		errx.WithType(errx.T_Validation),
+		errx.WithCode(CodeWeakPassword),
+		errx.WithDetails(errx.D{
+			"violations": violations,
+		}),
+	)
+}
+
+// Violations returns every rule of the policy which the password doesn't satisfy.
+func (p *Policy) Violations(password, username string) []Violation {
+	var violations []Violation
+
+	length := utf8.RuneCountInString(password)
+	if length < p.cfg.MinLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMinLength,
+			Message: fmt.Sprintf("password must be at least %d characters", p.cfg.MinLength),
+		})
+	}
+	if len(password) > maxLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMaxLength,
+			Message: fmt.Sprintf("password must be at most %d bytes", maxLength),
+		})
+	}
+
+	present := charClasses(password)
+	for _, class := range p.cfg.RequiredCharClasses {
+		if !present[class] {
+			violations = append(violations, Violation{
+				Rule:    RuleCharClass,
+				Message: fmt.Sprintf("password must contain at least one %s character", class),
+			})
+		}
+	}
+
+	if _, ok := p.denyList[strings.ToLower(password)]; ok {
+		violations = append(violations, Violation{
+			Rule:    RuleCommonPassword,
+			Message: "password is too common",
+		})
+	}
+
+	if username != "" && strings.EqualFold(password, username) {
+		violations = append(violations, Violation{
+			Rule:    RuleUsername,
+			Message: "password must not be equal to the username",
+		})
+	}
+
+	return violations
+}
+
+func charClasses(password string) map[string]bool {
+	classes := make(map[string]bool)
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			classes[CharClassLowercase] = true
+		case unicode.IsUpper(r):
+			classes[CharClassUppercase] = true
+		case unicode.IsDigit(r):
+			classes[CharClassDigit] = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			classes[CharClassSymbol] = true
+		}
+	}
+	return classes
+}
+
+func addDenied(denyList map[string]struct{}, data []byte) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		denyList[strings.ToLower(line)] = struct{}{}
+	}
+}
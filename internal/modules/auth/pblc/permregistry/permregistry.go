@@ -0,0 +1,167 @@
+// Package permregistry holds the catalogue of permissions declared by modules.
+package permregistry
+
+import (
+	"cmp"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"slices"
+	"sync"
+
+	"github.com/code19m/errx"
+)
+
+const (
+	CodeUnknownPermission   = "UNKNOWN_PERMISSION"
+	CodeDuplicatePermission = "DUPLICATE_PERMISSION"
+)
+
+// Permission is a registered permission of a module.
+type Permission struct {
+	Module      string `json:"module"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Registry is a concurrency safe in-memory catalogue of permissions.
+type Registry struct {
+	mu    sync.RWMutex
+	perms map[string]Permission
+}
+
+func New() *Registry {
+	return &Registry{
+		perms: make(map[string]Permission),
+	}
+}
+
+// Register adds the module's permissions to the catalogue.
+// Returns auth.CodeInvalidPermission coded validation error if any permission isn't a normalized,
+// valid permission without wildcards namespaced with the module's name,
+// and CodeDuplicatePermission coded conflict error if any permission is already registered.
+func (r *Registry) Register(module string, defs []auth.PermissionDef) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, def := range defs {
+		err := validateDefinition(module, def.Name)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+		if existing, ok := r.perms[def.Name]; ok {
+			return errx.New(
+				"permission is already registered",
+				errx.WithType(errx.T_Conflict),
+				errx.WithCode(CodeDuplicatePermission),
+				errx.WithDetails(errx.D{
+					"permission":        def.Name,
+					"module":            module,
+					"registered_module": existing.Module,
+				}),
+			)
+		}
+	}
+
+	for _, def := range defs {
+		r.perms[def.Name] = Permission{
+			Module:      module,
+			Name:        def.Name,
+			Description: def.Description,
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that every permission is grantable: a registered permission,
+// or a wildcard pattern matching at least one registered permission.
+// Permissions must be normalized with auth.NormalizePermission before validation.
+// Returns auth.CodeInvalidPermission coded validation error if any permission doesn't follow the grammar,
+// and CodeUnknownPermission coded validation error with the list of unknown permissions otherwise.
+func (r *Registry) Validate(names []string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var unknown []string
+	for _, name := range names {
+		err := auth.ValidatePermission(name)
+		if err != nil {
+			return errx.Wrap(err, errx.WithType(errx.T_Validation))
+		}
+		if !r.grantable(name) {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return errx.New(
+			"unknown permissions",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(CodeUnknownPermission),
+			errx.WithDetails(errx.D{"unknown_permissions": unknown}),
+		)
+	}
+
+	return nil
+}
+
+// List returns all registered permissions ordered by module and name.
+func (r *Registry) List() []Permission {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	perms := make([]Permission, 0, len(r.perms))
+	for _, p := range r.perms {
+		perms = append(perms, p)
+	}
+
+	slices.SortFunc(perms, func(a, b Permission) int {
+		return cmp.Or(cmp.Compare(a.Module, b.Module), cmp.Compare(a.Name, b.Name))
+	})
+
+	return perms
+}
+
+// Normalize normalizes the permissions with auth.NormalizePermission and returns them sorted and deduplicated.
+func Normalize(names []string) []string {
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		normalized = append(normalized, auth.NormalizePermission(name))
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// grantable reports whether the permission is registered or matches a registered permission. Must be called with mu held.
+func (r *Registry) grantable(name string) bool {
+	if _, ok := r.perms[name]; ok {
+		return true
+	}
+	if !auth.IsWildcardPermission(name) {
+		return false
+	}
+	for registered := range r.perms {
+		if auth.MatchPermission(name, registered) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDefinition(module, name string) error {
+	err := auth.ValidatePermission(name)
+	if err != nil {
+		return errx.Wrap(err, errx.WithType(errx.T_Validation))
+	}
+
+	if name != auth.NormalizePermission(name) ||
+		auth.IsWildcardPermission(name) ||
+		auth.PermissionNamespace(name) != module {
+		return errx.New(
+			"permission must be normalized, without wildcards and namespaced with the module's name",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(auth.CodeInvalidPermission),
+			errx.WithDetails(errx.D{"permission": name, "module": module}),
+		)
+	}
+	return nil
+}
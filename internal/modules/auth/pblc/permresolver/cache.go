@@ -0,0 +1,145 @@
+package permresolver
+
+import (
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"slices"
+	"sync"
+	"time"
+)
+
+type cacheKey struct {
+	actorType rbac.ActorType
+	actorID   string
+}
+
+type cacheEntry struct {
+	perms *Permissions
+	// roleIDs are the actor's roles and their ancestor roles the permissions were resolved from.
+	roleIDs   []int64
+	expiresAt time.Time
+}
+
+// cache holds resolved permissions of actors. It's enabled only while permission changes are listened to,
+// otherwise changes made by other instances would stay unnoticed until the entries expire.
+type cache struct {
+	maxEntries int
+
+	mu      sync.RWMutex
+	enabled bool
+	entries map[cacheKey]cacheEntry
+	// gen is incremented on every invalidation.
+	gen uint64
+}
+
+func newCache(maxEntries int) *cache {
+	return &cache{
+		maxEntries: maxEntries,
+		entries:    make(map[cacheKey]cacheEntry),
+	}
+}
+
+// get returns not expired permissions of the actor and whether the cache is enabled.
+func (c *cache) get(key cacheKey) (*Permissions, bool, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.enabled {
+		return nil, false, false
+	}
+
+	entry, ok := c.entries[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return nil, false, true
+	}
+	return entry.perms, true, true
+}
+
+// generation returns the current generation, which must be passed to put.
+func (c *cache) generation() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.gen
+}
+
+// put stores the entry if no invalidation happened since the generation was taken.
+func (c *cache) put(key cacheKey, entry cacheEntry, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.enabled || c.gen != generation {
+		return
+	}
+
+	if len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	c.entries[key] = entry
+}
+
+// invalidate drops entries affected by the change.
+func (c *cache) invalidate(change rbac.PermissionChange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+
+	switch {
+	case change.ActorID != "":
+		delete(c.entries, cacheKey{change.ActorType, change.ActorID})
+	case change.RoleID != 0:
+		for key, entry := range c.entries {
+			if slices.Contains(entry.roleIDs, change.RoleID) {
+				delete(c.entries, key)
+			}
+		}
+	default:
+		clear(c.entries)
+	}
+}
+
+// enable enables the cache.
+func (c *cache) enable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.enabled = true
+}
+
+// disable disables the cache and drops all entries.
+func (c *cache) disable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	c.enabled = false
+	clear(c.entries)
+}
+
+// evict drops expired entries, or an arbitrary entry if none is expired. Must be called with mu held.
+func (c *cache) evict() {
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	for key := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
+
+// scope returns a name of the change's scope for metrics.
+func scope(change rbac.PermissionChange) string {
+	switch {
+	case change.ActorID != "":
+		return "actor"
+	case change.RoleID != 0:
+		return "role"
+	default:
+		return "all"
+	}
+}
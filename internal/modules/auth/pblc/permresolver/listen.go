@@ -0,0 +1,76 @@
+package permresolver
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	listenMinBackoff = time.Second
+	listenMaxBackoff = 30 * time.Second
+)
+
+// NotifyChange drops cached permissions affected by the change and publishes it to other instances.
+// Must be called after the change is committed. Publishing errors are only logged,
+// since the change is already applied and other instances drop stale entries after the cache TTL anyway.
+func (r *Resolver) NotifyChange(ctx context.Context, change rbac.PermissionChange) {
+	r.applyChange(ctx, change)
+
+	err := r.domainContainer.PermissionChangeNotifier().Notify(ctx, change)
+	if err != nil {
+		logger.
+			WithContext(ctx).
+			With("actor_type", change.ActorType, "actor_id", change.ActorID, "role_id", change.RoleID).
+			Errorx(errx.Wrap(err))
+	}
+}
+
+// Start listens to permission changes published by all instances and enables the cache while listening.
+// Blocks until Stop is called, reconnecting with a backoff if listening fails.
+func (r *Resolver) Start() error {
+	backoff := listenMinBackoff
+
+	for {
+		startedAt := time.Now()
+		err := r.domainContainer.PermissionChangeNotifier().Listen(r.stopCtx, func(change rbac.PermissionChange) {
+			r.applyChange(r.stopCtx, change)
+			r.cache.enable()
+		})
+
+		// Changes are not received anymore, so cached permissions can't be trusted
+		r.cache.disable()
+
+		if r.stopCtx.Err() != nil {
+			return nil
+		}
+
+		logger.With("module", "auth").Errorx(errx.Wrap(err))
+
+		if time.Since(startedAt) > listenMaxBackoff {
+			backoff = listenMinBackoff
+		}
+		select {
+		case <-time.After(backoff):
+		case <-r.stopCtx.Done():
+			return nil
+		}
+		backoff = min(2*backoff, listenMaxBackoff)
+	}
+}
+
+// Stop stops listening to permission changes and disables the cache.
+func (r *Resolver) Stop() error {
+	r.stop()
+	return nil
+}
+
+func (r *Resolver) applyChange(ctx context.Context, change rbac.PermissionChange) {
+	r.cache.invalidate(change)
+	r.invalidations.Add(ctx, 1, metric.WithAttributes(attribute.String("scope", scope(change))))
+}
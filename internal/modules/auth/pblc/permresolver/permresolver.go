@@ -0,0 +1,330 @@
+// Package permresolver resolves effective permissions of actors
+// from their direct permissions and permissions inherited through roles.
+//
+// Resolved permissions are cached in process while the resolver listens to permission changes,
+// which RBAC use cases publish to every running instance with NotifyChange.
+package permresolver
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"slices"
+	"time"
+
+	"github.com/code19m/errx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "go-enterprise-blueprint/internal/modules/auth/pblc/permresolver"
+
+type Config struct {
+	// CacheTTL is a lifetime of cached permissions of an actor.
+	// It bounds the staleness if a change notification is lost. Default is 5 minutes.
+	CacheTTL time.Duration `yaml:"cache_ttl" validate:"required" default:"5m"`
+
+	// CacheMaxEntries is a maximum number of actors whose permissions are cached. Default is 10000.
+	CacheMaxEntries int `yaml:"cache_max_entries" validate:"required,min=1" default:"10000"`
+}
+
+// Permissions are the permissions of an actor grouped by their source.
+// Permissions may be shared with the cache, so they must not be modified.
+type Permissions struct {
+	// Direct are permissions assigned to the actor itself.
+	Direct []string
+	// FromRoles are permissions inherited through the actor's roles and their ancestor roles.
+	FromRoles []string
+	// Effective is a deduplicated union of Direct and FromRoles.
+	Effective []string
+}
+
+// IsSuperadmin reports whether the permissions include the superadmin permission.
+func (p *Permissions) IsSuperadmin() bool {
+	return slices.Contains(p.Effective, auth.PermissionSuperadmin)
+}
+
+// Has reports whether the permission is granted exactly or by a wildcard pattern, see auth.MatchPermission.
+// The permission is normalized before matching.
+// Superadmin permission is treated as a wildcard which grants every permission.
+func (p *Permissions) Has(permission string) bool {
+	if p.IsSuperadmin() {
+		return true
+	}
+
+	permission = auth.NormalizePermission(permission)
+	return slices.ContainsFunc(p.Effective, func(granted string) bool {
+		return auth.MatchPermission(granted, permission)
+	})
+}
+
+type Resolver struct {
+	cfg             Config
+	domainContainer *domain.Container
+
+	cache *cache
+
+	lookups       metric.Int64Counter
+	invalidations metric.Int64Counter
+
+	stopCtx context.Context
+	stop    context.CancelFunc
+}
+
+func New(cfg Config, domainContainer *domain.Container) (*Resolver, error) {
+	meter := otel.Meter(meterName)
+
+	lookups, err := meter.Int64Counter(
+		"auth.permission_cache.lookups",
+		metric.WithDescription("Lookups of cached actor permissions, the hit attribute reports whether they were cached"),
+	)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	invalidations, err := meter.Int64Counter(
+		"auth.permission_cache.invalidations",
+		metric.WithDescription("Invalidations of cached actor permissions by scope: actor, role or all"),
+	)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	stopCtx, stop := context.WithCancel(context.Background())
+
+	return &Resolver{
+		cfg:             cfg,
+		domainContainer: domainContainer,
+		cache:           newCache(cfg.CacheMaxEntries),
+		lookups:         lookups,
+		invalidations:   invalidations,
+		stopCtx:         stopCtx,
+		stop:            stop,
+	}, nil
+}
+
+// Resolve returns permissions of the actor.
+// Returns rbac.CodeInvalidActorType or rbac.CodeInvalidActorID coded errors on invalid input.
+func (r *Resolver) Resolve(ctx context.Context, actorType rbac.ActorType, actorID string) (*Permissions, error) {
+	if !actorType.IsValid() {
+		return nil, errx.New(
+			"invalid actor type",
+			errx.WithCode(rbac.CodeInvalidActorType),
+			errx.WithDetails(errx.D{"actor_type": actorType}),
+		)
+	}
+	if actorID == "" {
+		return nil, errx.New("actor id must not be empty", errx.WithCode(rbac.CodeInvalidActorID))
+	}
+
+	key := cacheKey{actorType, actorID}
+	perms, ok, enabled := r.cache.get(key)
+	if enabled {
+		r.lookups.Add(ctx, 1, metric.WithAttributes(attribute.Bool("hit", ok)))
+	}
+	if ok {
+		return perms, nil
+	}
+
+	// Permissions resolved concurrently with an invalidation may be stale, so they are not cached
+	generation := r.cache.generation()
+
+	now := time.Now()
+	change := &nextChange{}
+
+	direct, err := r.directPermissions(ctx, actorType, actorID, now, change)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	fromRoles, roleIDs, err := r.rolePermissions(ctx, actorType, actorID, now, change)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	perms = &Permissions{
+		Direct:    direct,
+		FromRoles: fromRoles,
+		Effective: sortedUnique(slices.Concat(direct, fromRoles)),
+	}
+
+	// Cached permissions must not outlive a grant which comes into effect or expires
+	expiresAt := now.Add(r.cfg.CacheTTL)
+	if change.at != nil && change.at.Before(expiresAt) {
+		expiresAt = *change.at
+	}
+	r.cache.put(key, cacheEntry{
+		perms:     perms,
+		roleIDs:   roleIDs,
+		expiresAt: expiresAt,
+	}, generation)
+
+	return perms, nil
+}
+
+// HasPermission reports whether the actor is granted the permission directly or through roles.
+func (r *Resolver) HasPermission(
+	ctx context.Context,
+	actorType rbac.ActorType,
+	actorID string,
+	permission string,
+) (bool, error) {
+	perms, err := r.Resolve(ctx, actorType, actorID)
+	if err != nil {
+		return false, errx.Wrap(err)
+	}
+	return perms.Has(permission), nil
+}
+
+// directPermissions returns permissions assigned to the actor which are in effect at now.
+func (r *Resolver) directPermissions(
+	ctx context.Context,
+	actorType rbac.ActorType,
+	actorID string,
+	now time.Time,
+	change *nextChange,
+) ([]string, error) {
+	actorPerms, err := r.domainContainer.ActorPermissionRepo().List(ctx, rbac.ActorPermissionFilter{
+		ActorType: &actorType,
+		ActorID:   &actorID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	perms := make([]string, 0, len(actorPerms))
+	for _, ap := range actorPerms {
+		change.observe(ap.Validity, now)
+		if ap.IsValidAt(now) {
+			perms = append(perms, ap.Permission)
+		}
+	}
+	return sortedUnique(perms), nil
+}
+
+// rolePermissions returns permissions inherited through the actor's roles in effect at now
+// and their ancestor roles, and IDs of all these roles.
+func (r *Resolver) rolePermissions(
+	ctx context.Context,
+	actorType rbac.ActorType,
+	actorID string,
+	now time.Time,
+	change *nextChange,
+) ([]string, []int64, error) {
+	assignedIDs, err := r.assignedRoleIDs(ctx, actorType, actorID, now, change)
+	if err != nil {
+		return nil, nil, errx.Wrap(err)
+	}
+	if len(assignedIDs) == 0 {
+		return []string{}, []int64{}, nil
+	}
+
+	_, roleIDs, err := r.roleHierarchy(ctx, assignedIDs)
+	if err != nil {
+		return nil, nil, errx.Wrap(err)
+	}
+
+	rolePerms, err := r.domainContainer.RolePermissionRepo().List(ctx, rbac.RolePermissionFilter{
+		RoleIDs: roleIDs,
+	})
+	if err != nil {
+		return nil, nil, errx.Wrap(err)
+	}
+
+	perms := make([]string, 0, len(rolePerms))
+	for _, rp := range rolePerms {
+		perms = append(perms, rp.Permission)
+	}
+	return sortedUnique(perms), roleIDs, nil
+}
+
+// assignedRoleIDs returns IDs of roles assigned to the actor which are in effect at now.
+func (r *Resolver) assignedRoleIDs(
+	ctx context.Context,
+	actorType rbac.ActorType,
+	actorID string,
+	now time.Time,
+	change *nextChange,
+) ([]int64, error) {
+	actorRoles, err := r.domainContainer.ActorRoleRepo().List(ctx, rbac.ActorRoleFilter{
+		ActorType: &actorType,
+		ActorID:   &actorID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	roleIDs := make([]int64, 0, len(actorRoles))
+	for _, ar := range actorRoles {
+		change.observe(ar.Validity, now)
+		if ar.IsValidAt(now) {
+			roleIDs = append(roleIDs, ar.RoleID)
+		}
+	}
+	return roleIDs, nil
+}
+
+// roleHierarchy walks parent links up from the roles level by level.
+// It returns the walked part of the hierarchy and IDs of the roles with all their ancestors.
+func (r *Resolver) roleHierarchy(ctx context.Context, roleIDs []int64) (rbac.Hierarchy, []int64, error) {
+	var parents []rbac.RoleParent
+	visited := make(map[int64]struct{}, len(roleIDs))
+	level := make([]int64, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		if _, ok := visited[id]; !ok {
+			visited[id] = struct{}{}
+			level = append(level, id)
+		}
+	}
+
+	for len(level) > 0 {
+		levelParents, err := r.domainContainer.RoleParentRepo().List(ctx, rbac.RoleParentFilter{RoleIDs: level})
+		if err != nil {
+			return nil, nil, errx.Wrap(err)
+		}
+		parents = append(parents, levelParents...)
+
+		// Visited roles are skipped, so the walk ends even if the stored hierarchy has a cycle
+		var next []int64
+		for _, rp := range levelParents {
+			if _, ok := visited[rp.ParentRoleID]; !ok {
+				visited[rp.ParentRoleID] = struct{}{}
+				next = append(next, rp.ParentRoleID)
+			}
+		}
+		level = next
+	}
+
+	allIDs := make([]int64, 0, len(visited))
+	for id := range visited {
+		allIDs = append(allIDs, id)
+	}
+	slices.Sort(allIDs)
+
+	return rbac.NewHierarchy(parents), allIDs, nil
+}
+
+// nextChange tracks the earliest moment a resolved grant comes into effect or expires.
+type nextChange struct {
+	at *time.Time
+}
+
+// observe takes the grant's validity into account. Does nothing on a nil receiver.
+func (c *nextChange) observe(v rbac.Validity, now time.Time) {
+	if c == nil {
+		return
+	}
+	t := v.NextChangeAfter(now)
+	if t != nil && (c.at == nil || t.Before(*c.at)) {
+		c.at = t
+	}
+}
+
+func sortedUnique(perms []string) []string {
+	result := make([]string, len(perms))
+	copy(result, perms)
+	slices.Sort(result)
+	return slices.Compact(result)
+}
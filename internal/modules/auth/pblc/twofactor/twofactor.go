@@ -0,0 +1,208 @@
+// Package twofactor implements TOTP based second factor of admin logins:
+// enrollment secrets, login challenges and single-use recovery codes.
+package twofactor
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"go-enterprise-blueprint/pkg/totp"
+	"strings"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/token"
+)
+
+const (
+	// codeSkew is a number of adjacent TOTP steps accepted to tolerate clock drift.
+	codeSkew = 1
+
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 5
+)
+
+type Config struct {
+	// Issuer is shown in authenticator apps next to the account name.
+	Issuer string `yaml:"issuer" validate:"required" default:"go-enterprise-blueprint"`
+
+	// SecretEncryptionKey is a hex encoded 32 bytes key used to encrypt TOTP secrets at rest.
+	SecretEncryptionKey string `yaml:"secret_encryption_key" validate:"required,len=64,hexadecimal"`
+
+	// ChallengeTTL is a lifetime of login challenges. Default is 5 minutes.
+	ChallengeTTL time.Duration `yaml:"challenge_ttl" validate:"required" default:"5m"`
+
+	// MaxChallengeAttempts is a number of wrong codes after which a login challenge is dropped. Default is 5.
+	MaxChallengeAttempts int `yaml:"max_challenge_attempts" validate:"required,min=1" default:"5"`
+}
+
+// Enrollment is a newly generated TOTP secret of an admin.
+type Enrollment struct {
+	// Secret is the plain base32 secret to be shown to the admin once.
+	Secret string
+	// URI is an otpauth:// URI of the secret, usually rendered as a QR code.
+	URI string
+	// EncryptedSecret is the secret as it is stored in the database.
+	EncryptedSecret string
+}
+
+// Challenge is a newly issued login challenge.
+type Challenge struct {
+	// Token is the plain token returned to the client.
+	Token string
+	// Hash is a hash of the token as it is stored in the database.
+	Hash      string
+	ExpiresAt time.Time
+}
+
+type Manager struct {
+	cfg  Config
+	aead cipher.AEAD
+}
+
+func NewManager(cfg Config) (*Manager, error) {
+	key, err := hex.DecodeString(cfg.SecretEncryptionKey)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Manager{
+		cfg,
+		aead,
+	}, nil
+}
+
+// MaxChallengeAttempts returns a number of wrong codes after which a login challenge is dropped.
+func (m *Manager) MaxChallengeAttempts() int {
+	return m.cfg.MaxChallengeAttempts
+}
+
+// Enroll generates a new TOTP secret for the account.
+func (m *Manager) Enroll(account string) (*Enrollment, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	encrypted, err := m.encrypt(secret)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Enrollment{
+		Secret:          secret,
+		URI:             totp.URI(m.cfg.Issuer, account, secret),
+		EncryptedSecret: encrypted,
+	}, nil
+}
+
+// VerifyCode checks the TOTP code against the encrypted secret.
+// Codes of a time step not after lastUsedStep are rejected, so a code can't be replayed.
+// Returns the matched time step to be saved as the new last used step.
+func (m *Manager) VerifyCode(encryptedSecret, code string, lastUsedStep int64) (int64, bool, error) {
+	secret, err := m.decrypt(encryptedSecret)
+	if err != nil {
+		return 0, false, errx.Wrap(err)
+	}
+
+	step, ok, err := totp.Validate(secret, code, time.Now(), codeSkew)
+	if err != nil {
+		return 0, false, errx.Wrap(err)
+	}
+	if !ok || step <= lastUsedStep {
+		return 0, false, nil
+	}
+	return step, true, nil
+}
+
+// NewRecoveryCodes generates a set of recovery codes and their hashes.
+func (m *Manager) NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	for range recoveryCodeCount {
+		b := make([]byte, recoveryCodeBytes)
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, nil, errx.Wrap(err)
+		}
+		raw := strings.ToLower(enc.EncodeToString(b))
+		code := raw[:len(raw)/2] + "-" + raw[len(raw)/2:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, m.HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns a hash of the recovery code as it is stored in the database.
+// The code is normalized first, so it may be entered in any case and with or without the dash.
+func (m *Manager) HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	return hash(normalized)
+}
+
+// NewChallenge issues a new login challenge.
+func (m *Manager) NewChallenge() *Challenge {
+	t := token.NewOpaqueToken()
+	return &Challenge{
+		Token:     t,
+		Hash:      m.HashChallengeToken(t),
+		ExpiresAt: time.Now().Add(m.cfg.ChallengeTTL),
+	}
+}
+
+// HashChallengeToken returns a hash of the challenge token as it is stored in the database.
+func (m *Manager) HashChallengeToken(challengeToken string) string {
+	return hash(challengeToken)
+}
+
+func (m *Manager) encrypt(plain string) (string, error) {
+	nonce := make([]byte, m.aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", errx.Wrap(err)
+	}
+
+	sealed := m.aead.Seal(nonce, nonce, []byte(plain), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (m *Manager) decrypt(encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", errx.Wrap(err)
+	}
+
+	nonceSize := m.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errx.New("encrypted secret is too short")
+	}
+
+	plain, err := m.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", errx.Wrap(err)
+	}
+	return string(plain), nil
+}
+
+// hash is used for high entropy random values only, so a fast hash is enough to protect them at rest.
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,64 @@
+package portal
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/token"
+)
+
+func (p *portal) Authenticate(ctx context.Context, accessToken string) (*auth.Actor, error) {
+	// Verify signature and expiration of the access token
+	claims, err := p.pblcContainer.TokenManager().VerifyAccessToken(accessToken)
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Authentication, token.CodeInvalidToken, token.CodeExpiredToken)
+	}
+
+	// Find the session, so logged out and revoked sessions are rejected
+	isRotated := false
+	accessTokenHash := p.pblcContainer.TokenManager().HashSessionToken(accessToken)
+	sess, err := p.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ActorType:       &claims.ActorType,
+		ActorID:         &claims.ActorID,
+		AccessTokenHash: &accessTokenHash,
+		IsRotated:       &isRotated,
+	})
+	if errx.IsCodeIn(err, session.CodeSessionNotFound) {
+		return nil, errx.New(
+			"session of the access token is not found",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(auth.CodeInvalidAccessToken),
+		)
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	if time.Now().After(sess.AccessTokenExpiresAt) {
+		return nil, errx.New(
+			"access token is expired",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(token.CodeExpiredToken),
+		)
+	}
+
+	if p.pblcContainer.ActivityTracker().IsIdle(sess) {
+		return nil, errx.New(
+			"session is expired due to inactivity",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(session.CodeSessionIdleTimeout),
+		)
+	}
+
+	// Record usage of the session, written to the database in the background
+	p.pblcContainer.ActivityTracker().Touch(sess)
+
+	return &auth.Actor{
+		Type:      sess.ActorType,
+		ID:        sess.ActorID,
+		SessionID: sess.ID,
+	}, nil
+}
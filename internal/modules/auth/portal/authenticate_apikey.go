@@ -0,0 +1,60 @@
+package portal
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+)
+
+func (p *portal) AuthenticateAPIKey(ctx context.Context, apiKey string) (*auth.Actor, error) {
+	// Find the key by its hash, revoked keys are rejected
+	keyHash := p.pblcContainer.TokenManager().HashAPIKey(apiKey)
+	isRevoked := false
+	key, err := p.domainContainer.APIKeyRepo().Get(ctx, apikey.Filter{
+		KeyHash:   &keyHash,
+		IsRevoked: &isRevoked,
+	})
+	if errx.IsCodeIn(err, apikey.CodeAPIKeyNotFound) {
+		return nil, invalidAPIKeyError("api key is not found or revoked")
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	if key.IsExpired(time.Now()) {
+		return nil, invalidAPIKeyError("api key is expired")
+	}
+
+	// Check that the service account is active
+	account, err := p.domainContainer.ServiceAccountRepo().Get(ctx, user.ServiceAccountFilter{
+		ID: &key.ServiceAccountID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !account.IsActive {
+		return nil, errx.New(
+			"service account is disabled",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(user.CodeServiceAccountDisabled),
+		)
+	}
+
+	return &auth.Actor{
+		Type:     auth.ActorTypeServiceAcc,
+		ID:       account.ID,
+		APIKeyID: key.ID,
+	}, nil
+}
+
+func invalidAPIKeyError(msg string) error {
+	return errx.New(
+		msg,
+		errx.WithType(errx.T_Authentication),
+		errx.WithCode(auth.CodeInvalidAPIKey),
+	)
+}
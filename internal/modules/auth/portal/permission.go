@@ -0,0 +1,27 @@
+package portal
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"slices"
+
+	"github.com/code19m/errx"
+)
+
+func (p *portal) HasPermission(ctx context.Context, actorType, actorID, permission string) (bool, error) {
+	ok, err := p.pblcContainer.PermissionResolver().HasPermission(ctx, rbac.ActorType(actorType), actorID, permission)
+	return ok, errx.Wrap(err)
+}
+
+func (p *portal) GetActorPermissions(ctx context.Context, actorType, actorID string) ([]string, error) {
+	perms, err := p.pblcContainer.PermissionResolver().Resolve(ctx, rbac.ActorType(actorType), actorID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	return slices.Clone(perms.Effective), nil
+}
+
+func (p *portal) RegisterPermissions(module string, perms []auth.PermissionDef) error {
+	return errx.Wrap(p.pblcContainer.PermissionRegistry().Register(module, perms))
+}
@@ -1,9 +1,19 @@
 package portal
 
-import "go-enterprise-blueprint/internal/portal/auth"
+import (
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+)
 
-type portal struct{}
+type portal struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
 
-func New() auth.Portal {
-	return &portal{}
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) auth.Portal {
+	return &portal{
+		domainContainer,
+		pblcContainer,
+	}
 }
@@ -0,0 +1,131 @@
+package adminchangepassword
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	CurrentPassword string `json:"current_password" validate:"required" mask:"true"`
+	NewPassword     string `json:"new_password" validate:"required" mask:"true"`
+}
+
+type Output struct {
+	// RevokedSessions is a number of other sessions of the admin which were revoked.
+	RevokedSessions int `json:"revoked_sessions"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-change-password" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find the calling admin
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &actor.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeAdminNotFound)
+	}
+
+	// Check if username and client IP address are not locked out, so a stolen session can't guess the password
+	err = uc.pblcContainer.LoginGuard().Check(ctx, rbac.ActorTypeAdmin, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Verify current password
+	if !hasher.Compare(input.CurrentPassword, admin.PasswordHash) {
+		err = uc.pblcContainer.LoginGuard().RegisterFailure(ctx, rbac.ActorTypeAdmin, admin.Username)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		return nil, errx.New(
+			"current password is incorrect",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(user.CodeInvalidCurrentPassword),
+		)
+	}
+
+	// Check if new password satisfies the password policy
+	err = uc.pblcContainer.PasswordPolicy().Validate(input.NewPassword, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Hash the new password
+	passwordHash, err := hasher.Hash(input.NewPassword)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find current session, sessions of other logins are revoked
+	current, err := uc.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ID:        &actor.SessionID,
+		ActorType: &actor.Type,
+		ActorID:   &actor.ID,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, session.CodeSessionNotFound)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Update password hash
+	admin.PasswordHash = passwordHash
+	_, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Revoke sessions of other logins, rotated sessions are not counted as revoked
+	others, err := uow.Session().DeleteByActor(ctx, actor.Type, actor.ID, &current.FamilyID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	revoked := 0
+	for _, s := range others {
+		if s.RotatedAt == nil {
+			revoked++
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		RevokedSessions: revoked,
+	}, nil
+}
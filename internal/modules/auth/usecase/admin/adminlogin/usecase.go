@@ -0,0 +1,192 @@
+package adminlogin
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Username string `json:"username" validate:"required,min=3,max=50"`
+	Password string `json:"password" validate:"required" mask:"true"`
+}
+
+// Output contains either the admin and session info, or a second factor challenge
+// if the admin has two-factor authentication enabled.
+type Output struct {
+	Admin        *AdminInfo        `json:"admin"`
+	Session      *SessionInfo      `json:"session"`
+	MFAChallenge *MFAChallengeInfo `json:"mfa_challenge"`
+}
+
+type AdminInfo struct {
+	ID           string     `json:"id"`
+	Username     string     `json:"username"`
+	IsSuperadmin bool       `json:"is_superadmin"`
+	IsActive     bool       `json:"is_active"`
+	LastActiveAt *time.Time `json:"last_active_at"`
+}
+
+type SessionInfo struct {
+	AccessToken           string    `json:"access_token" mask:"true"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token" mask:"true"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type MFAChallengeInfo struct {
+	ChallengeToken string    `json:"challenge_token" mask:"true"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-login" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Check if username and client IP address are not locked out
+	err := uc.pblcContainer.LoginGuard().Check(ctx, rbac.ActorTypeAdmin, input.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find admin by username
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{
+		Username: &input.Username,
+	})
+	if errx.IsCodeIn(err, user.CodeAdminNotFound) {
+		return nil, uc.invalidCredentials(ctx, input.Username)
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Verify password hash
+	if !hasher.Compare(input.Password, admin.PasswordHash) {
+		return nil, uc.invalidCredentials(ctx, input.Username)
+	}
+
+	// Check if admin is active
+	if !admin.IsActive {
+		return nil, errx.New(
+			"admin account is disabled",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeAdminDisabled),
+		)
+	}
+
+	// Require the second factor if two-factor authentication is enabled
+	if admin.IsMFAEnabled() {
+		return uc.challenge(ctx, admin)
+	}
+
+	// Check if admin is superadmin
+	perms, err := uc.pblcContainer.PermissionResolver().Resolve(ctx, rbac.ActorTypeAdmin, admin.ID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Issue access and refresh tokens and create session record with IP address and user agent
+	now := time.Now()
+	tokens, err := uc.pblcContainer.SessionManager().Start(ctx, uow, rbac.ActorTypeAdmin, admin.ID, now)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Update admin's last_active_at timestamp
+	admin.LastActiveAt = &now
+	admin, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Forget failed attempts of the username
+	err = uc.pblcContainer.LoginGuard().RegisterSuccess(ctx, rbac.ActorTypeAdmin, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		Admin: &AdminInfo{
+			ID:           admin.ID,
+			Username:     admin.Username,
+			IsSuperadmin: perms.IsSuperadmin(),
+			IsActive:     admin.IsActive,
+			LastActiveAt: admin.LastActiveAt,
+		},
+		Session: &SessionInfo{
+			AccessToken:           tokens.AccessToken,
+			AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+			RefreshToken:          tokens.RefreshToken,
+			RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		},
+	}, nil
+}
+
+// challenge creates a login challenge to be completed with admin-mfa-verify instead of a session.
+func (uc *usecase) challenge(ctx context.Context, admin *user.Admin) (*Output, error) {
+	ch := uc.pblcContainer.TwoFactorManager().NewChallenge()
+
+	_, err := uc.domainContainer.LoginChallengeRepo().Create(ctx, &mfa.LoginChallenge{
+		AdminID:   admin.ID,
+		TokenHash: ch.Hash,
+		ExpiresAt: ch.ExpiresAt,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		MFAChallenge: &MFAChallengeInfo{
+			ChallengeToken: ch.Token,
+			ExpiresAt:      ch.ExpiresAt,
+		},
+	}, nil
+}
+
+// invalidCredentials counts a failed attempt and returns an error which doesn't tell whether the username exists.
+func (uc *usecase) invalidCredentials(ctx context.Context, username string) error {
+	err := uc.pblcContainer.LoginGuard().RegisterFailure(ctx, rbac.ActorTypeAdmin, username)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	return errx.New(
+		"invalid username or password",
+		errx.WithType(errx.T_Authentication),
+		errx.WithCode(user.CodeInvalidCredentials),
+	)
+}
@@ -0,0 +1,141 @@
+package adminmfaconfirm
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Code string `json:"code" validate:"required,len=6,numeric" mask:"true"`
+}
+
+type Output struct {
+	MFAEnabledAt  time.Time `json:"mfa_enabled_at"`
+	RecoveryCodes []string  `json:"recovery_codes" mask:"true"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-mfa-confirm" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find the calling admin
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &actor.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeAdminNotFound)
+	}
+
+	// Check if two-factor authentication is not enabled yet
+	if admin.IsMFAEnabled() {
+		return nil, errx.New(
+			"two-factor authentication is already enabled",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(mfa.CodeMFAAlreadyEnabled),
+		)
+	}
+
+	// Check if enrollment is started
+	if admin.MFASecret == nil {
+		return nil, errx.New(
+			"two-factor authentication enrollment is not started",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(mfa.CodeMFANotEnrolled),
+		)
+	}
+
+	// Verify the code from the authenticator app
+	step, ok, err := uc.pblcContainer.TwoFactorManager().VerifyCode(*admin.MFASecret, input.Code, admin.MFALastUsedStep)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !ok {
+		return nil, errx.New(
+			"two-factor authentication code is invalid",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(mfa.CodeInvalidMFACode),
+		)
+	}
+
+	// Generate recovery codes
+	codes, hashes, err := uc.pblcContainer.TwoFactorManager().NewRecoveryCodes()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find recovery codes left from a previous enrollment
+	oldCodes, err := uc.domainContainer.RecoveryCodeRepo().List(ctx, mfa.RecoveryCodeFilter{AdminID: &admin.ID})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Replace recovery codes
+	if len(oldCodes) > 0 {
+		err = uow.RecoveryCode().BulkDelete(ctx, oldCodes)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+	newCodes := make([]mfa.RecoveryCode, 0, len(hashes))
+	for _, h := range hashes {
+		newCodes = append(newCodes, mfa.RecoveryCode{
+			AdminID:  admin.ID,
+			CodeHash: h,
+		})
+	}
+	err = uow.RecoveryCode().BulkCreate(ctx, newCodes)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Enable two-factor authentication
+	now := time.Now()
+	admin.MFAEnabledAt = &now
+	admin.MFALastUsedStep = step
+	_, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		MFAEnabledAt:  now,
+		RecoveryCodes: codes,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package adminmfaenroll
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct{}
+
+type Output struct {
+	Secret     string `json:"secret" mask:"true"`
+	OTPAuthURI string `json:"otpauth_uri" mask:"true"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-mfa-enroll" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find the calling admin
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &actor.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeAdminNotFound)
+	}
+
+	// Check if two-factor authentication is not enabled yet
+	if admin.IsMFAEnabled() {
+		return nil, errx.New(
+			"two-factor authentication is already enabled",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(mfa.CodeMFAAlreadyEnabled),
+		)
+	}
+
+	// Generate a new secret, replacing a pending one of an unconfirmed enrollment
+	enrollment, err := uc.pblcContainer.TwoFactorManager().Enroll(admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Save the encrypted secret
+	admin.MFASecret = &enrollment.EncryptedSecret
+	admin.MFALastUsedStep = 0
+	_, err = uc.domainContainer.AdminRepo().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	}, nil
+}
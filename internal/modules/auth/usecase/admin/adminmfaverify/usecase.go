@@ -0,0 +1,245 @@
+package adminmfaverify
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+// Input requires exactly one of code and recovery code.
+type Input struct {
+	ChallengeToken string `json:"challenge_token" validate:"required" mask:"true"`
+	Code           string `json:"code" validate:"required_without=RecoveryCode,excluded_with=RecoveryCode,omitempty,len=6,numeric" mask:"true"`
+	RecoveryCode   string `json:"recovery_code" validate:"omitempty,max=20" mask:"true"`
+}
+
+type Output struct {
+	Admin   AdminInfo   `json:"admin"`
+	Session SessionInfo `json:"session"`
+}
+
+type AdminInfo struct {
+	ID           string     `json:"id"`
+	Username     string     `json:"username"`
+	IsSuperadmin bool       `json:"is_superadmin"`
+	IsActive     bool       `json:"is_active"`
+	LastActiveAt *time.Time `json:"last_active_at"`
+}
+
+type SessionInfo struct {
+	AccessToken           string    `json:"access_token" mask:"true"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token" mask:"true"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-mfa-verify" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	twoFactor := uc.pblcContainer.TwoFactorManager()
+
+	// Find login challenge by token
+	tokenHash := twoFactor.HashChallengeToken(input.ChallengeToken)
+	challenge, err := uc.domainContainer.LoginChallengeRepo().Get(ctx, mfa.LoginChallengeFilter{
+		TokenHash: &tokenHash,
+	})
+	if errx.IsCodeIn(err, mfa.CodeChallengeNotFound) {
+		return nil, errInvalidChallenge()
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Check if challenge is not expired
+	if time.Now().After(challenge.ExpiresAt) {
+		err = uc.domainContainer.LoginChallengeRepo().Delete(ctx, challenge)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		return nil, errInvalidChallenge()
+	}
+
+	// Check if challenge's admin is still active
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{
+		ID: &challenge.AdminID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !admin.IsActive {
+		return nil, errx.New(
+			"admin account is disabled",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeAdminDisabled),
+		)
+	}
+	if !admin.IsMFAEnabled() || admin.MFASecret == nil {
+		return nil, errInvalidChallenge()
+	}
+
+	// Check if username and client IP address are not locked out
+	err = uc.pblcContainer.LoginGuard().Check(ctx, rbac.ActorTypeAdmin, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Verify TOTP code or find unused recovery code
+	var recoveryCode *mfa.RecoveryCode
+	if input.Code != "" {
+		var (
+			step int64
+			ok   bool
+		)
+		step, ok, err = twoFactor.VerifyCode(*admin.MFASecret, input.Code, admin.MFALastUsedStep)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if !ok {
+			return nil, uc.failAttempt(ctx, challenge, admin)
+		}
+		admin.MFALastUsedStep = step
+	} else {
+		codeHash := twoFactor.HashRecoveryCode(input.RecoveryCode)
+		isUsed := false
+		recoveryCode, err = uc.domainContainer.RecoveryCodeRepo().Get(ctx, mfa.RecoveryCodeFilter{
+			AdminID:  &admin.ID,
+			CodeHash: &codeHash,
+			IsUsed:   &isUsed,
+		})
+		if errx.IsCodeIn(err, mfa.CodeRecoveryCodeNotFound) {
+			return nil, uc.failAttempt(ctx, challenge, admin)
+		}
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Check if admin is superadmin
+	perms, err := uc.pblcContainer.PermissionResolver().Resolve(ctx, rbac.ActorTypeAdmin, admin.ID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Delete the challenge, so it can't be completed twice
+	err = uow.LoginChallenge().Delete(ctx, challenge)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Mark recovery code as used
+	now := time.Now()
+	if recoveryCode != nil {
+		recoveryCode.UsedAt = &now
+		_, err = uow.RecoveryCode().Update(ctx, recoveryCode)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Issue access and refresh tokens and create session record with IP address and user agent
+	tokens, err := uc.pblcContainer.SessionManager().Start(ctx, uow, rbac.ActorTypeAdmin, admin.ID, now)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Update admin's last_active_at timestamp and last used TOTP step
+	admin.LastActiveAt = &now
+	admin, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Forget failed attempts of the username
+	err = uc.pblcContainer.LoginGuard().RegisterSuccess(ctx, rbac.ActorTypeAdmin, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		Admin: AdminInfo{
+			ID:           admin.ID,
+			Username:     admin.Username,
+			IsSuperadmin: perms.IsSuperadmin(),
+			IsActive:     admin.IsActive,
+			LastActiveAt: admin.LastActiveAt,
+		},
+		Session: SessionInfo{
+			AccessToken:           tokens.AccessToken,
+			AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+			RefreshToken:          tokens.RefreshToken,
+			RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		},
+	}, nil
+}
+
+// failAttempt counts a wrong code on the challenge and drops the challenge after too many of them.
+// The failure is also counted as a failed login of the admin, so new challenges can't be used to guess codes.
+func (uc *usecase) failAttempt(ctx context.Context, challenge *mfa.LoginChallenge, admin *user.Admin) error {
+	err := uc.pblcContainer.LoginGuard().RegisterFailure(ctx, rbac.ActorTypeAdmin, admin.Username)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	challenge.Attempts++
+	remaining := uc.pblcContainer.TwoFactorManager().MaxChallengeAttempts() - challenge.Attempts
+
+	if remaining > 0 {
+		_, err = uc.domainContainer.LoginChallengeRepo().Update(ctx, challenge)
+	} else {
+		err = uc.domainContainer.LoginChallengeRepo().Delete(ctx, challenge)
+	}
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	return errx.New(
+		"two-factor authentication code is invalid",
+		errx.WithType(errx.T_Authentication),
+		errx.WithCode(mfa.CodeInvalidMFACode),
+		errx.WithDetails(errx.D{
+			"remaining_attempts": max(remaining, 0),
+		}),
+	)
+}
+
+func errInvalidChallenge() error {
+	return errx.New(
+		"login challenge is invalid or expired",
+		errx.WithType(errx.T_Authentication),
+		errx.WithCode(mfa.CodeInvalidChallenge),
+	)
+}
@@ -0,0 +1,73 @@
+package adminrefreshtoken
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	RefreshToken string `json:"refresh_token" validate:"required" mask:"true"`
+}
+
+type Output struct {
+	AccessToken           string    `json:"access_token" mask:"true"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token" mask:"true"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-refresh-token" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Rotate the session of the refresh token
+	tokens, err := uc.pblcContainer.SessionManager().Refresh(ctx, rbac.ActorTypeAdmin, input.RefreshToken, uc.isActive)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+	}, nil
+}
+
+// isActive checks if session's admin is still active.
+func (uc *usecase) isActive(ctx context.Context, adminID string) error {
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{
+		ID: &adminID,
+	})
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	if !admin.IsActive {
+		return errx.New(
+			"admin account is disabled",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeAdminDisabled),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package adminresetpassword
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/passwordreset"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ResetToken  string `json:"reset_token" validate:"required" mask:"true"`
+	NewPassword string `json:"new_password" validate:"required" mask:"true"`
+}
+
+type Output struct {
+	Success bool `json:"success"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "admin-reset-password" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find unused reset token by hash
+	tokenHash := uc.pblcContainer.TokenManager().HashPasswordResetToken(input.ResetToken)
+	isUsed := false
+	resetToken, err := uc.domainContainer.PasswordResetTokenRepo().Get(ctx, passwordreset.Filter{
+		TokenHash: &tokenHash,
+		IsUsed:    &isUsed,
+	})
+	if errx.IsCodeIn(err, passwordreset.CodeTokenNotFound) {
+		return nil, errInvalidToken()
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Check if reset token is not expired
+	if time.Now().After(resetToken.ExpiresAt) {
+		return nil, errInvalidToken()
+	}
+
+	// Check if token's admin is still active
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &resetToken.AdminID})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !admin.IsActive {
+		return nil, errx.New(
+			"admin account is disabled",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeAdminDisabled),
+		)
+	}
+
+	// Check if new password satisfies the password policy
+	err = uc.pblcContainer.PasswordPolicy().Validate(input.NewPassword, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Hash the new password
+	passwordHash, err := hasher.Hash(input.NewPassword)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Mark reset token as used, so it can't be used twice, a concurrent request may have used it already
+	marked, err := uow.PasswordResetToken().MarkUsed(ctx, resetToken.ID, time.Now())
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !marked {
+		return nil, errInvalidToken()
+	}
+
+	// Update password hash
+	admin.PasswordHash = passwordHash
+	_, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Revoke all sessions and pending login challenges
+	_, err = uow.Session().DeleteByActor(ctx, string(rbac.ActorTypeAdmin), admin.ID, nil)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	_, err = uow.LoginChallenge().DeleteByAdmin(ctx, admin.ID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Forget failed login attempts of the username
+	err = uc.pblcContainer.LoginGuard().RegisterSuccess(ctx, rbac.ActorTypeAdmin, admin.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{Success: true}, nil
+}
+
+func errInvalidToken() error {
+	return errx.New(
+		"password reset token is invalid, expired or already used",
+		errx.WithType(errx.T_Validation),
+		errx.WithCode(passwordreset.CodeInvalidToken),
+	)
+}
@@ -0,0 +1,104 @@
+package createadmin
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/google/uuid"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Username     string `json:"username" validate:"required,min=3,max=50"`
+	Password     string `json:"password" validate:"required" mask:"true"`
+	IsSuperadmin bool   `json:"is_superadmin"`
+}
+
+type Output struct {
+	ID           string    `json:"id"`
+	Username     string    `json:"username"`
+	IsSuperadmin bool      `json:"is_superadmin"`
+	IsActive     bool      `json:"is_active"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "create-admin" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Check if password satisfies the password policy
+	err := uc.pblcContainer.PasswordPolicy().Validate(input.Password, input.Username)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Hash the password
+	passwordHash, err := hasher.Hash(input.Password)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Create admin, unique username is enforced by the database
+	admin, err := uow.Admin().Create(ctx, &user.Admin{
+		ID:           uuid.NewString(),
+		Username:     input.Username,
+		PasswordHash: passwordHash,
+		IsActive:     true,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, user.CodeAdminUsernameConflict)
+	}
+
+	// Grant superadmin permission if requested
+	if input.IsSuperadmin {
+		_, err = uow.ActorPermission().Create(ctx, &rbac.ActorPermission{
+			ActorType:  rbac.ActorTypeAdmin,
+			ActorID:    admin.ID,
+			Permission: auth.PermissionSuperadmin,
+		})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		ID:           admin.ID,
+		Username:     admin.Username,
+		IsSuperadmin: input.IsSuperadmin,
+		IsActive:     admin.IsActive,
+		CreatedAt:    admin.CreatedAt,
+	}, nil
+}
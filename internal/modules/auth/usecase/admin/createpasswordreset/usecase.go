@@ -0,0 +1,113 @@
+package createpasswordreset
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/passwordreset"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	AdminID string `json:"admin_id" validate:"required,uuid"`
+}
+
+type Output struct {
+	AdminID    string    `json:"admin_id"`
+	ResetToken string    `json:"reset_token" mask:"true"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "create-password-reset" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find admin by ID
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &input.AdminID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeAdminNotFound)
+	}
+
+	// Check if admin is active
+	if !admin.IsActive {
+		return nil, errx.New(
+			"admin account is disabled",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(user.CodeAdminDisabled),
+		)
+	}
+
+	// Find unused tokens issued before, only the latest token stays valid
+	isUsed := false
+	previous, err := uc.domainContainer.PasswordResetTokenRepo().List(ctx, passwordreset.Filter{
+		AdminID: &admin.ID,
+		IsUsed:  &isUsed,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Generate reset token
+	resetToken := uc.pblcContainer.TokenManager().IssuePasswordResetToken()
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Delete previous unused tokens
+	if len(previous) > 0 {
+		err = uow.PasswordResetToken().BulkDelete(ctx, previous)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Create token record storing only its hash
+	_, err = uow.PasswordResetToken().Create(ctx, &passwordreset.PasswordResetToken{
+		AdminID:   admin.ID,
+		TokenHash: resetToken.Hash,
+		ExpiresAt: resetToken.ExpiresAt,
+		CreatedBy: actor.ID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		AdminID:    admin.ID,
+		ResetToken: resetToken.Token,
+		ExpiresAt:  resetToken.ExpiresAt,
+	}, nil
+}
@@ -5,34 +5,57 @@ import (
 	"go-enterprise-blueprint/internal/modules/auth/domain"
 	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
 	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
 	"go-enterprise-blueprint/internal/portal/auth"
 
 	"github.com/code19m/errx"
 	"github.com/google/uuid"
 	"github.com/rise-and-shine/pkg/hasher"
 	"github.com/rise-and-shine/pkg/ucdef"
+	"github.com/rise-and-shine/pkg/val"
 )
 
 type Input struct {
-	Username string
-	Password string
+	Username string `validate:"required,min=3,max=50"`
+	Password string `validate:"required" mask:"true"`
 }
 
 type UseCase = ucdef.ManualCommand[*Input]
 
 type usecase struct {
 	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
 }
 
-func New(domainContainer *domain.Container) UseCase {
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
 	return &usecase{
 		domainContainer,
+		pblcContainer,
 	}
 }
 
 func (uc *usecase) OperationID() string { return "create-superadmin" }
 
 func (uc *usecase) Execute(ctx context.Context, input *Input) error {
+	// Validate input, manual commands are not validated by a transport
+	err := val.ValidateSchema(input)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Check if admin with the username already exists before the password policy,
+	// so re-runs of a bootstrap job report the existing superadmin whatever password they are given
+	err = uc.checkExisting(ctx, input.Username)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Check if password satisfies the password policy
+	err = uc.pblcContainer.PasswordPolicy().Validate(input.Password, input.Username)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
 	// Hash the password
 	passwordHash, err := hasher.Hash(input.Password)
 	if err != nil {
@@ -54,7 +77,7 @@ func (uc *usecase) Execute(ctx context.Context, input *Input) error {
 		IsActive:     true,
 	})
 	if err != nil {
-		return errx.Wrap(err)
+		return errx.WrapWithTypeOnCodes(err, errx.T_Conflict, user.CodeAdminUsernameConflict)
 	}
 
 	// Create actor permission with superadmin permission
@@ -71,3 +94,34 @@ func (uc *usecase) Execute(ctx context.Context, input *Input) error {
 	err = uow.ApplyChanges()
 	return errx.Wrap(err)
 }
+
+// checkExisting returns user.CodeAdminUsernameConflict coded error if an active superadmin with the username exists,
+// and user.CodeAdminNotActiveSuperadmin coded error if the admin with the username is disabled or not a superadmin.
+func (uc *usecase) checkExisting(ctx context.Context, username string) error {
+	admin, err := uc.domainContainer.AdminRepo().FirstOrNil(ctx, user.AdminFilter{Username: &username})
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	if admin == nil {
+		return nil
+	}
+
+	isSuperadmin, err := uc.pblcContainer.SuperadminChecker().IsSuperadmin(ctx, admin.ID)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	if !admin.IsActive || !isSuperadmin {
+		return errx.New(
+			"admin with this username already exists but is not an active superadmin",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(user.CodeAdminNotActiveSuperadmin),
+			errx.WithDetails(errx.D{"is_active": admin.IsActive, "is_superadmin": isSuperadmin}),
+		)
+	}
+
+	return errx.New(
+		"superadmin with this username already exists",
+		errx.WithType(errx.T_Conflict),
+		errx.WithCode(user.CodeAdminUsernameConflict),
+	)
+}
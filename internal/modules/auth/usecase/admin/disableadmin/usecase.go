@@ -0,0 +1,113 @@
+package disableadmin
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type Output struct {
+	ID                 string `json:"id"`
+	Username           string `json:"username"`
+	IsActive           bool   `json:"is_active"`
+	SessionsTerminated int    `json:"sessions_terminated"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "disable-admin" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find admin by ID
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &input.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeAdminNotFound)
+	}
+
+	if !admin.IsActive {
+		return nil, errx.New(
+			"admin is already disabled",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(user.CodeAdminAlreadyDisabled),
+		)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Check that admin is not the last active superadmin, superadmin grants stay locked until the UOW ends
+	isLast, err := uc.pblcContainer.SuperadminChecker().IsLastActive(ctx, uow, admin.ID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if isLast {
+		return nil, errx.New(
+			"cannot disable the last active superadmin",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeCannotDisableLastSuperadmin),
+		)
+	}
+
+	// Disable admin
+	admin.IsActive = false
+	admin, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Delete all sessions of the admin
+	sessions, err := uow.Session().DeleteByActor(ctx, string(rbac.ActorTypeAdmin), admin.ID, nil)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		ID:                 admin.ID,
+		Username:           admin.Username,
+		IsActive:           admin.IsActive,
+		SessionsTerminated: countActive(sessions),
+	}, nil
+}
+
+// countActive counts sessions which were not rotated by refresh token usage.
+func countActive(sessions []session.Session) int {
+	count := 0
+	for _, s := range sessions {
+		if s.RotatedAt == nil {
+			count++
+		}
+	}
+	return count
+}
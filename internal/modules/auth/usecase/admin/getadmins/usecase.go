@@ -0,0 +1,87 @@
+package getadmins
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/pagination"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	pagination.Request
+
+	IsActive *bool   `query:"is_active"`
+	Username *string `query:"username" validate:"omitempty,max=50"`
+}
+
+type Output = pagination.Response[AdminInfo]
+
+type AdminInfo struct {
+	ID           string     `json:"id"`
+	Username     string     `json:"username"`
+	IsSuperadmin bool       `json:"is_superadmin"`
+	IsActive     bool       `json:"is_active"`
+	LastActiveAt *time.Time `json:"last_active_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "get-admins" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	input.Normalize()
+
+	// Query admins with pagination, ordered by username
+	admins, total, err := uc.domainContainer.AdminRepo().ListWithCount(ctx, user.AdminFilter{
+		IsActive:       input.IsActive,
+		UsernamePrefix: input.Username,
+		Limit:          input.Limit(),
+		Offset:         input.Offset(),
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find superadmins among the page
+	ids := make([]string, 0, len(admins))
+	for _, a := range admins {
+		ids = append(ids, a.ID)
+	}
+	superadmins, err := uc.pblcContainer.SuperadminChecker().Filter(ctx, ids)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	items := make([]AdminInfo, 0, len(admins))
+	for _, a := range admins {
+		items = append(items, AdminInfo{
+			ID:           a.ID,
+			Username:     a.Username,
+			IsSuperadmin: superadmins[a.ID],
+			IsActive:     a.IsActive,
+			LastActiveAt: a.LastActiveAt,
+			CreatedAt:    a.CreatedAt,
+		})
+	}
+
+	resp := pagination.NewResponse(items, int64(total), input.Request)
+	return &resp, nil
+}
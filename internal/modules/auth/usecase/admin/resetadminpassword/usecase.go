@@ -0,0 +1,114 @@
+package resetadminpassword
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/mfa"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Username string
+	Password string
+
+	// ResetMFA disables two-factor authentication of the admin, e.g. if the authenticator device is lost too.
+	ResetMFA bool
+}
+
+type UseCase = ucdef.ManualCommand[*Input]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "reset-admin-password" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) error {
+	// Find admin by username
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{Username: &input.Username})
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Check if password satisfies the password policy
+	err = uc.pblcContainer.PasswordPolicy().Validate(input.Password, admin.Username)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Hash the password
+	passwordHash, err := hasher.Hash(input.Password)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Find recovery codes to be deleted with two-factor authentication
+	var recoveryCodes []mfa.RecoveryCode
+	if input.ResetMFA {
+		recoveryCodes, err = uc.domainContainer.RecoveryCodeRepo().List(ctx, mfa.RecoveryCodeFilter{
+			AdminID: &admin.ID,
+		})
+		if err != nil {
+			return errx.Wrap(err)
+		}
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Update password hash and reset two-factor authentication if requested
+	admin.PasswordHash = passwordHash
+	if input.ResetMFA {
+		admin.MFASecret = nil
+		admin.MFAEnabledAt = nil
+		admin.MFALastUsedStep = 0
+	}
+	_, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	if len(recoveryCodes) > 0 {
+		err = uow.RecoveryCode().BulkDelete(ctx, recoveryCodes)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+	}
+
+	// Revoke all sessions and pending login challenges
+	_, err = uow.Session().DeleteByActor(ctx, string(rbac.ActorTypeAdmin), admin.ID, nil)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	_, err = uow.LoginChallenge().DeleteByAdmin(ctx, admin.ID)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	// Forget failed login attempts of the username, so the admin can log in right away
+	err = uc.pblcContainer.LoginGuard().RegisterSuccess(ctx, rbac.ActorTypeAdmin, admin.Username)
+	return errx.Wrap(err)
+}
@@ -0,0 +1,209 @@
+package updateadmin
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ID           string  `json:"id" validate:"required,uuid"`
+	Username     *string `json:"username" validate:"omitempty,min=3,max=50"`
+	Password     *string `json:"password" mask:"true"`
+	IsSuperadmin *bool   `json:"is_superadmin"`
+}
+
+type Output struct {
+	ID           string    `json:"id"`
+	Username     string    `json:"username"`
+	IsSuperadmin bool      `json:"is_superadmin"`
+	IsActive     bool      `json:"is_active"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "update-admin" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find admin by ID
+	admin, err := uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &input.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeAdminNotFound)
+	}
+
+	isSuperadmin, err := uc.pblcContainer.SuperadminChecker().IsSuperadmin(ctx, admin.ID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	demote := input.IsSuperadmin != nil && !*input.IsSuperadmin && isSuperadmin
+	promote := input.IsSuperadmin != nil && *input.IsSuperadmin && !isSuperadmin
+
+	if input.Username != nil {
+		admin.Username = *input.Username
+	}
+
+	// Check if new password satisfies the password policy and find the login to be kept on revocation
+	var keepFamilyID *string
+	if input.Password != nil {
+		err = uc.pblcContainer.PasswordPolicy().Validate(*input.Password, admin.Username)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+
+		admin.PasswordHash, err = hasher.Hash(*input.Password)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+
+		var familyID string
+		familyID, err = uc.callerFamilyID(ctx, admin.ID)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if familyID != "" {
+			keepFamilyID = &familyID
+		}
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Check that the last superadmin is not demoted, superadmin grants stay locked until the UOW ends
+	if demote {
+		var isLast bool
+		isLast, err = uc.pblcContainer.SuperadminChecker().IsLastActive(ctx, uow, admin.ID)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if isLast {
+			return nil, errx.New(
+				"cannot remove superadmin permission from the last active superadmin",
+				errx.WithType(errx.T_Forbidden),
+				errx.WithCode(user.CodeCannotDemoteLastSuperadmin),
+			)
+		}
+	}
+
+	// Update admin record, unique username is enforced by the database
+	admin, err = uow.Admin().Update(ctx, admin)
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, user.CodeAdminUsernameConflict)
+	}
+
+	// Revoke sessions and pending login challenges after password change
+	if input.Password != nil {
+		_, err = uow.Session().DeleteByActor(ctx, string(rbac.ActorTypeAdmin), admin.ID, keepFamilyID)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		_, err = uow.LoginChallenge().DeleteByAdmin(ctx, admin.ID)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Grant or revoke superadmin permission,
+	// a grant not in effect (expired or not started yet) is replaced on promotion
+	actorType := rbac.ActorTypeAdmin
+	permission := auth.PermissionSuperadmin
+	if promote || demote {
+		var perms []rbac.ActorPermission
+		perms, err = uow.ActorPermission().List(ctx, rbac.ActorPermissionFilter{
+			ActorType:  &actorType,
+			ActorID:    &admin.ID,
+			Permission: &permission,
+		})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if len(perms) > 0 {
+			err = uow.ActorPermission().BulkDelete(ctx, perms)
+			if err != nil {
+				return nil, errx.Wrap(err)
+			}
+		}
+	}
+	if promote {
+		_, err = uow.ActorPermission().Create(ctx, &rbac.ActorPermission{
+			ActorType:  actorType,
+			ActorID:    admin.ID,
+			Permission: permission,
+		})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of the admin on all instances
+	if promote || demote {
+		uc.pblcContainer.PermissionResolver().NotifyChange(ctx, rbac.PermissionChange{
+			ActorType: actorType,
+			ActorID:   admin.ID,
+		})
+	}
+
+	return &Output{
+		ID:           admin.ID,
+		Username:     admin.Username,
+		IsSuperadmin: (isSuperadmin || promote) && !demote,
+		IsActive:     admin.IsActive,
+		UpdatedAt:    admin.UpdatedAt,
+	}, nil
+}
+
+// callerFamilyID returns family of the calling session if the admin changes its own password,
+// sessions of the calling login are kept on revocation. Returns an empty string for any other caller.
+func (uc *usecase) callerFamilyID(ctx context.Context, adminID string) (string, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return "", errx.Wrap(err)
+	}
+
+	actorType := string(rbac.ActorTypeAdmin)
+	if actor.Type != actorType || actor.ID != adminID {
+		return "", nil
+	}
+
+	current, err := uc.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ID:        &actor.SessionID,
+		ActorType: &actorType,
+		ActorID:   &adminID,
+	})
+	if err != nil {
+		return "", errx.WrapWithTypeOnCodes(err, errx.T_NotFound, session.CodeSessionNotFound)
+	}
+	return current.FamilyID, nil
+}
@@ -1,21 +1,367 @@
 package usecase
 
 import (
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminchangepassword"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminlogin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminmfaconfirm"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminmfaenroll"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminmfaverify"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminrefreshtoken"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/adminresetpassword"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createpasswordreset"
 	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/createsuperadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/disableadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/getadmins"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/resetadminpassword"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/admin/updateadmin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/lockout/cleanuploginfailures"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/lockout/unlockaccount"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/cleanupexpiredgrants"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/explainactorpermission"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/getpermissions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setactorpermission"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setactorrole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setroleparents"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/rbac/setrolepermission"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/createrole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/deleterole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/getroles"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/role/updaterole"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/createapikey"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/createserviceaccount"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/revokeapikey"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/serviceacc/rotateapikey"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/cleanupexpiredsessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/deleteuserallsessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/deleteusersession"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/getmysessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/session/logoutothersessions"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/getprofile"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/updateprofile"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userlogin"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userlogout"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userrefreshtoken"
+	"go-enterprise-blueprint/internal/modules/auth/usecase/user/userregister"
 )
 
 type Container struct {
-	createSuperadmin createsuperadmin.UseCase
+	createSuperadmin     createsuperadmin.UseCase
+	adminLogin           adminlogin.UseCase
+	adminRefreshToken    adminrefreshtoken.UseCase
+	createAdmin          createadmin.UseCase
+	updateAdmin          updateadmin.UseCase
+	disableAdmin         disableadmin.UseCase
+	getAdmins            getadmins.UseCase
+	adminMFAEnroll       adminmfaenroll.UseCase
+	adminMFAConfirm      adminmfaconfirm.UseCase
+	adminMFAVerify       adminmfaverify.UseCase
+	unlockAccount        unlockaccount.UseCase
+	cleanupLoginFailures cleanuploginfailures.UseCase
+
+	adminChangePassword adminchangepassword.UseCase
+	createPasswordReset createpasswordreset.UseCase
+	adminResetPassword  adminresetpassword.UseCase
+	resetAdminPassword  resetadminpassword.UseCase
+
+	getPermissions         getpermissions.UseCase
+	setRolePermission      setrolepermission.UseCase
+	setActorPermission     setactorpermission.UseCase
+	setActorRole           setactorrole.UseCase
+	setRoleParents         setroleparents.UseCase
+	explainActorPermission explainactorpermission.UseCase
+	cleanupExpiredGrants   cleanupexpiredgrants.UseCase
+
+	createRole createrole.UseCase
+	updateRole updaterole.UseCase
+	deleteRole deleterole.UseCase
+	getRoles   getroles.UseCase
+
+	createServiceAccount createserviceaccount.UseCase
+	createAPIKey         createapikey.UseCase
+	rotateAPIKey         rotateapikey.UseCase
+	revokeAPIKey         revokeapikey.UseCase
+
+	deleteUserSession      deleteusersession.UseCase
+	deleteUserAllSessions  deleteuserallsessions.UseCase
+	getMySessions          getmysessions.UseCase
+	logoutOtherSessions    logoutothersessions.UseCase
+	cleanupExpiredSessions cleanupexpiredsessions.UseCase
+
+	userRegister     userregister.UseCase
+	userLogin        userlogin.UseCase
+	userRefreshToken userrefreshtoken.UseCase
+	userLogout       userlogout.UseCase
+	getProfile       getprofile.UseCase
+	updateProfile    updateprofile.UseCase
 }
 
 func NewContainer(
 	createSuperadmin createsuperadmin.UseCase,
+	adminLogin adminlogin.UseCase,
+	adminRefreshToken adminrefreshtoken.UseCase,
+	createAdmin createadmin.UseCase,
+	updateAdmin updateadmin.UseCase,
+	disableAdmin disableadmin.UseCase,
+	getAdmins getadmins.UseCase,
+	adminMFAEnroll adminmfaenroll.UseCase,
+	adminMFAConfirm adminmfaconfirm.UseCase,
+	adminMFAVerify adminmfaverify.UseCase,
+	unlockAccount unlockaccount.UseCase,
+	cleanupLoginFailures cleanuploginfailures.UseCase,
+
+	adminChangePassword adminchangepassword.UseCase,
+	createPasswordReset createpasswordreset.UseCase,
+	adminResetPassword adminresetpassword.UseCase,
+	resetAdminPassword resetadminpassword.UseCase,
+
+	getPermissions getpermissions.UseCase,
+	setRolePermission setrolepermission.UseCase,
+	setActorPermission setactorpermission.UseCase,
+	setActorRole setactorrole.UseCase,
+	setRoleParents setroleparents.UseCase,
+	explainActorPermission explainactorpermission.UseCase,
+	cleanupExpiredGrants cleanupexpiredgrants.UseCase,
+
+	createRole createrole.UseCase,
+	updateRole updaterole.UseCase,
+	deleteRole deleterole.UseCase,
+	getRoles getroles.UseCase,
+
+	createServiceAccount createserviceaccount.UseCase,
+	createAPIKey createapikey.UseCase,
+	rotateAPIKey rotateapikey.UseCase,
+	revokeAPIKey revokeapikey.UseCase,
+
+	deleteUserSession deleteusersession.UseCase,
+	deleteUserAllSessions deleteuserallsessions.UseCase,
+	getMySessions getmysessions.UseCase,
+	logoutOtherSessions logoutothersessions.UseCase,
+	cleanupExpiredSessions cleanupexpiredsessions.UseCase,
+
+	userRegister userregister.UseCase,
+	userLogin userlogin.UseCase,
+	userRefreshToken userrefreshtoken.UseCase,
+	userLogout userlogout.UseCase,
+	getProfile getprofile.UseCase,
+	updateProfile updateprofile.UseCase,
 ) *Container {
 	return &Container{
-		createSuperadmin: createSuperadmin,
+		createSuperadmin:     createSuperadmin,
+		adminLogin:           adminLogin,
+		adminRefreshToken:    adminRefreshToken,
+		createAdmin:          createAdmin,
+		updateAdmin:          updateAdmin,
+		disableAdmin:         disableAdmin,
+		getAdmins:            getAdmins,
+		adminMFAEnroll:       adminMFAEnroll,
+		adminMFAConfirm:      adminMFAConfirm,
+		adminMFAVerify:       adminMFAVerify,
+		unlockAccount:        unlockAccount,
+		cleanupLoginFailures: cleanupLoginFailures,
+
+		adminChangePassword: adminChangePassword,
+		createPasswordReset: createPasswordReset,
+		adminResetPassword:  adminResetPassword,
+		resetAdminPassword:  resetAdminPassword,
+
+		getPermissions:         getPermissions,
+		setRolePermission:      setRolePermission,
+		setActorPermission:     setActorPermission,
+		setActorRole:           setActorRole,
+		setRoleParents:         setRoleParents,
+		explainActorPermission: explainActorPermission,
+		cleanupExpiredGrants:   cleanupExpiredGrants,
+
+		createRole: createRole,
+		updateRole: updateRole,
+		deleteRole: deleteRole,
+		getRoles:   getRoles,
+
+		createServiceAccount: createServiceAccount,
+		createAPIKey:         createAPIKey,
+		rotateAPIKey:         rotateAPIKey,
+		revokeAPIKey:         revokeAPIKey,
+
+		deleteUserSession:      deleteUserSession,
+		deleteUserAllSessions:  deleteUserAllSessions,
+		getMySessions:          getMySessions,
+		logoutOtherSessions:    logoutOtherSessions,
+		cleanupExpiredSessions: cleanupExpiredSessions,
+
+		userRegister:     userRegister,
+		userLogin:        userLogin,
+		userRefreshToken: userRefreshToken,
+		userLogout:       userLogout,
+		getProfile:       getProfile,
+		updateProfile:    updateProfile,
 	}
 }
 
 func (c *Container) CreateSuperadmin() createsuperadmin.UseCase {
 	return c.createSuperadmin
 }
+
+func (c *Container) AdminLogin() adminlogin.UseCase {
+	return c.adminLogin
+}
+
+func (c *Container) AdminRefreshToken() adminrefreshtoken.UseCase {
+	return c.adminRefreshToken
+}
+
+func (c *Container) CreateAdmin() createadmin.UseCase {
+	return c.createAdmin
+}
+
+func (c *Container) UpdateAdmin() updateadmin.UseCase {
+	return c.updateAdmin
+}
+
+func (c *Container) DisableAdmin() disableadmin.UseCase {
+	return c.disableAdmin
+}
+
+func (c *Container) GetAdmins() getadmins.UseCase {
+	return c.getAdmins
+}
+
+func (c *Container) AdminMFAEnroll() adminmfaenroll.UseCase {
+	return c.adminMFAEnroll
+}
+
+func (c *Container) AdminMFAConfirm() adminmfaconfirm.UseCase {
+	return c.adminMFAConfirm
+}
+
+func (c *Container) AdminMFAVerify() adminmfaverify.UseCase {
+	return c.adminMFAVerify
+}
+
+func (c *Container) UnlockAccount() unlockaccount.UseCase {
+	return c.unlockAccount
+}
+
+func (c *Container) CleanupLoginFailures() cleanuploginfailures.UseCase {
+	return c.cleanupLoginFailures
+}
+
+func (c *Container) AdminChangePassword() adminchangepassword.UseCase {
+	return c.adminChangePassword
+}
+
+func (c *Container) CreatePasswordReset() createpasswordreset.UseCase {
+	return c.createPasswordReset
+}
+
+func (c *Container) AdminResetPassword() adminresetpassword.UseCase {
+	return c.adminResetPassword
+}
+
+func (c *Container) ResetAdminPassword() resetadminpassword.UseCase {
+	return c.resetAdminPassword
+}
+
+func (c *Container) GetPermissions() getpermissions.UseCase {
+	return c.getPermissions
+}
+
+func (c *Container) SetRolePermission() setrolepermission.UseCase {
+	return c.setRolePermission
+}
+
+func (c *Container) SetActorPermission() setactorpermission.UseCase {
+	return c.setActorPermission
+}
+
+func (c *Container) SetActorRole() setactorrole.UseCase {
+	return c.setActorRole
+}
+
+func (c *Container) SetRoleParents() setroleparents.UseCase {
+	return c.setRoleParents
+}
+
+func (c *Container) ExplainActorPermission() explainactorpermission.UseCase {
+	return c.explainActorPermission
+}
+
+func (c *Container) CleanupExpiredGrants() cleanupexpiredgrants.UseCase {
+	return c.cleanupExpiredGrants
+}
+
+func (c *Container) CreateRole() createrole.UseCase {
+	return c.createRole
+}
+
+func (c *Container) UpdateRole() updaterole.UseCase {
+	return c.updateRole
+}
+
+func (c *Container) DeleteRole() deleterole.UseCase {
+	return c.deleteRole
+}
+
+func (c *Container) GetRoles() getroles.UseCase {
+	return c.getRoles
+}
+
+func (c *Container) CreateServiceAccount() createserviceaccount.UseCase {
+	return c.createServiceAccount
+}
+
+func (c *Container) CreateAPIKey() createapikey.UseCase {
+	return c.createAPIKey
+}
+
+func (c *Container) RotateAPIKey() rotateapikey.UseCase {
+	return c.rotateAPIKey
+}
+
+func (c *Container) RevokeAPIKey() revokeapikey.UseCase {
+	return c.revokeAPIKey
+}
+
+func (c *Container) DeleteUserSession() deleteusersession.UseCase {
+	return c.deleteUserSession
+}
+
+func (c *Container) DeleteUserAllSessions() deleteuserallsessions.UseCase {
+	return c.deleteUserAllSessions
+}
+
+func (c *Container) GetMySessions() getmysessions.UseCase {
+	return c.getMySessions
+}
+
+func (c *Container) LogoutOtherSessions() logoutothersessions.UseCase {
+	return c.logoutOtherSessions
+}
+
+func (c *Container) CleanupExpiredSessions() cleanupexpiredsessions.UseCase {
+	return c.cleanupExpiredSessions
+}
+
+func (c *Container) UserRegister() userregister.UseCase {
+	return c.userRegister
+}
+
+func (c *Container) UserLogin() userlogin.UseCase {
+	return c.userLogin
+}
+
+func (c *Container) UserRefreshToken() userrefreshtoken.UseCase {
+	return c.userRefreshToken
+}
+
+func (c *Container) UserLogout() userlogout.UseCase {
+	return c.userLogout
+}
+
+func (c *Container) GetProfile() getprofile.UseCase {
+	return c.getProfile
+}
+
+func (c *Container) UpdateProfile() updateprofile.UseCase {
+	return c.updateProfile
+}
@@ -0,0 +1,67 @@
+package cleanuploginfailures
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Config struct {
+	// BatchSize is a maximum number of counters deleted by a single statement. Default is 1000.
+	BatchSize int `yaml:"batch_size" validate:"min=1" default:"1000"`
+
+	// MaxBatches is a maximum number of batches deleted by a single run,
+	// remaining counters are deleted by the next runs. Default is 100.
+	MaxBatches int `yaml:"max_batches" validate:"min=1" default:"100"`
+}
+
+type Payload struct{}
+
+type UseCase = ucdef.AsyncTask[*Payload]
+
+type usecase struct {
+	cfg             Config
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(cfg Config, domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		cfg,
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "cleanup-login-failures" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Payload) error {
+	now := time.Now()
+	windowStart := uc.pblcContainer.LoginGuard().ForgottenBefore(now)
+
+	// Delete forgotten counters in bounded batches, so a single statement doesn't lock the table for long
+	total := 0
+	for range uc.cfg.MaxBatches {
+		deleted, err := uc.domainContainer.LoginFailureRepo().DeleteStale(ctx, windowStart, now, uc.cfg.BatchSize)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		total += deleted
+		if deleted < uc.cfg.BatchSize {
+			break
+		}
+	}
+
+	logger.
+		WithContext(ctx).
+		With("deleted_count", total, "last_failed_before", windowStart).
+		Info("stale login failures cleaned up")
+
+	return nil
+}
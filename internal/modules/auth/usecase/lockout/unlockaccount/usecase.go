@@ -0,0 +1,80 @@
+package unlockaccount
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/lockout"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"strings"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ActorType string `json:"actor_type" validate:"required,oneof=admin user"`
+	// Username is admin's username or user's email.
+	Username  string `json:"username" validate:"required_without=IPAddress,max=254"`
+	IPAddress string `json:"ip_address" validate:"omitempty,ip"`
+}
+
+type Output struct {
+	WasLocked bool `json:"was_locked"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "unlock-account" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	subjects := make(map[lockout.Scope]string)
+	if input.Username != "" {
+		username := input.Username
+		if input.ActorType == string(rbac.ActorTypeUser) {
+			username = strings.ToLower(strings.TrimSpace(username))
+		}
+		subjects[lockout.ScopeUsername] = username
+	}
+	if input.IPAddress != "" {
+		subjects[lockout.ScopeIPAddress] = input.IPAddress
+	}
+
+	// Delete failure counters of the given subjects
+	now := time.Now()
+	wasLocked := false
+	for scope, subject := range subjects {
+		scopeStr := string(scope)
+		failure, err := uc.domainContainer.LoginFailureRepo().FirstOrNil(ctx, lockout.Filter{
+			ActorType: &input.ActorType,
+			Scope:     &scopeStr,
+			Subject:   &subject,
+		})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if failure == nil {
+			continue
+		}
+
+		wasLocked = wasLocked || failure.IsLocked(now)
+		err = uc.domainContainer.LoginFailureRepo().Delete(ctx, failure)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	return &Output{
+		WasLocked: wasLocked,
+	}, nil
+}
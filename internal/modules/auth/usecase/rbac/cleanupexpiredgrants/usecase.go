@@ -0,0 +1,181 @@
+package cleanupexpiredgrants
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Config struct {
+	// BatchSize is a maximum number of grants removed by a single transaction. Default is 500.
+	BatchSize int `yaml:"batch_size" validate:"min=1" default:"500"`
+
+	// MaxBatches is a maximum number of batches of each grant type removed by a single run,
+	// remaining grants are removed by the next runs. Default is 20.
+	MaxBatches int `yaml:"max_batches" validate:"min=1" default:"20"`
+}
+
+type Payload struct{}
+
+type UseCase = ucdef.AsyncTask[*Payload]
+
+type usecase struct {
+	cfg             Config
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(cfg Config, domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		cfg,
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "cleanup-expired-grants" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Payload) error {
+	now := time.Now()
+
+	// Remove expired actor permissions in bounded batches
+	permissionCount := 0
+	for range uc.cfg.MaxBatches {
+		removed, err := uc.removeBatch(ctx, now, uc.expiredActorPermissions)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		permissionCount += removed
+		if removed < uc.cfg.BatchSize {
+			break
+		}
+	}
+
+	// Remove expired actor roles in bounded batches
+	roleCount := 0
+	for range uc.cfg.MaxBatches {
+		removed, err := uc.removeBatch(ctx, now, uc.expiredActorRoles)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		roleCount += removed
+		if removed < uc.cfg.BatchSize {
+			break
+		}
+	}
+
+	logger.
+		WithContext(ctx).
+		With("actor_permission_count", permissionCount, "actor_role_count", roleCount, "expired_before", now).
+		Info("expired grants cleaned up")
+
+	return nil
+}
+
+// deleteExpiredFunc deletes a batch of expired grants within the UOW and returns events about them.
+type deleteExpiredFunc func(ctx context.Context, uow uowRepos, now time.Time) ([]rbac.GrantExpired, error)
+
+// uowRepos are repositories of the UOW used to delete expired grants.
+type uowRepos interface {
+	ActorPermission() rbac.ActorPermissionRepo
+	ActorRole() rbac.ActorRoleRepo
+}
+
+// removeBatch deletes a batch of expired grants and publishes events about them in a single transaction,
+// so grants are removed only if the events are published. Returns the number of removed grants.
+func (uc *usecase) removeBatch(ctx context.Context, now time.Time, deleteExpired deleteExpiredFunc) (int, error) {
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Delete a batch of expired grants
+	events, err := deleteExpired(ctx, uow, now)
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	// Publish events before committing, a failed commit may only lead to duplicate events
+	err = uc.domainContainer.GrantEventPublisher().PublishGrantsExpired(ctx, events)
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return 0, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of affected actors on all instances
+	notified := make(map[rbac.PermissionChange]struct{}, len(events))
+	for _, e := range events {
+		change := rbac.PermissionChange{ActorType: e.ActorType, ActorID: e.ActorID}
+		if _, ok := notified[change]; ok {
+			continue
+		}
+		notified[change] = struct{}{}
+		uc.pblcContainer.PermissionResolver().NotifyChange(ctx, change)
+	}
+
+	return len(events), nil
+}
+
+func (uc *usecase) expiredActorPermissions(
+	ctx context.Context,
+	uow uowRepos,
+	now time.Time,
+) ([]rbac.GrantExpired, error) {
+	deleted, err := uow.ActorPermission().DeleteExpired(ctx, now, uc.cfg.BatchSize)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	events := make([]rbac.GrantExpired, 0, len(deleted))
+	for _, ap := range deleted {
+		events = append(events, rbac.GrantExpired{
+			GrantType:  rbac.GrantTypeActorPermission,
+			GrantID:    ap.ID,
+			ActorType:  ap.ActorType,
+			ActorID:    ap.ActorID,
+			Permission: ap.Permission,
+			ValidUntil: *ap.ValidUntil,
+			RemovedAt:  now,
+		})
+	}
+	return events, nil
+}
+
+func (uc *usecase) expiredActorRoles(ctx context.Context, uow uowRepos, now time.Time) ([]rbac.GrantExpired, error) {
+	deleted, err := uow.ActorRole().DeleteExpired(ctx, now, uc.cfg.BatchSize)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	events := make([]rbac.GrantExpired, 0, len(deleted))
+	for _, ar := range deleted {
+		events = append(events, rbac.GrantExpired{
+			GrantType:  rbac.GrantTypeActorRole,
+			GrantID:    ar.ID,
+			ActorType:  ar.ActorType,
+			ActorID:    ar.ActorID,
+			RoleID:     ar.RoleID,
+			ValidUntil: *ar.ValidUntil,
+			RemovedAt:  now,
+		})
+	}
+	return events, nil
+}
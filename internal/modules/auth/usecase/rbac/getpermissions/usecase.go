@@ -0,0 +1,35 @@
+package getpermissions
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permregistry"
+
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct{}
+
+type Output struct {
+	Items []permregistry.Permission `json:"items"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	pblcContainer *pblc.Container
+}
+
+func New(pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "get-permissions" }
+
+func (uc *usecase) Execute(_ context.Context, _ *Input) (*Output, error) {
+	return &Output{
+		Items: uc.pblcContainer.PermissionRegistry().List(),
+	}, nil
+}
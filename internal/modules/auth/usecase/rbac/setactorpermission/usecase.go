@@ -0,0 +1,186 @@
+package setactorpermission
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permregistry"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"slices"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ActorType   string   `json:"actor_type" validate:"required,oneof=user admin service_acc"`
+	ActorID     string   `json:"actor_id" validate:"required,uuid"`
+	Permissions []string `json:"permissions" validate:"dive,required"`
+
+	// Validity makes the listed permissions time-bound, other permissions are granted without bounds.
+	Validity map[string]rbac.Validity `json:"validity"`
+}
+
+type Output struct {
+	ActorType   string                   `json:"actor_type"`
+	ActorID     string                   `json:"actor_id"`
+	Permissions []string                 `json:"permissions"`
+	Validity    map[string]rbac.Validity `json:"validity"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "set-actor-permission" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actorType := rbac.ActorType(input.ActorType)
+	permissions := permregistry.Normalize(input.Permissions)
+
+	// Validate permissions follow the grammar and are registered or match registered ones
+	err := uc.pblcContainer.PermissionRegistry().Validate(permissions)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Validate validity bounds of time-bound permissions
+	validity, err := normalizeValidity(input.Validity, permissions, time.Now())
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, rbac.CodeInvalidGrantValidity)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Compute diff against existing actor permissions
+	existing, err := uow.ActorPermission().List(ctx, rbac.ActorPermissionFilter{
+		ActorType: &actorType,
+		ActorID:   &input.ActorID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	toCreate, toDelete := rbac.Diff(existing, func(ap rbac.ActorPermission) string { return ap.Permission }, permissions)
+
+	// Check that superadmin permission is not revoked from or made time-bound for the last active superadmin
+	holdsSuperadmin := slices.ContainsFunc(existing, func(ap rbac.ActorPermission) bool {
+		return ap.Permission == auth.PermissionSuperadmin
+	})
+	demote := !slices.Contains(permissions, auth.PermissionSuperadmin) ||
+		validity[auth.PermissionSuperadmin] != (rbac.Validity{})
+	if actorType == rbac.ActorTypeAdmin && holdsSuperadmin && demote {
+		var isLast bool
+		isLast, err = uc.pblcContainer.SuperadminChecker().IsLastActive(ctx, uow, input.ActorID)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if isLast {
+			return nil, errx.New(
+				"cannot remove superadmin permission from the last active superadmin",
+				errx.WithType(errx.T_Forbidden),
+				errx.WithCode(user.CodeCannotDemoteLastSuperadmin),
+			)
+		}
+	}
+
+	// Delete revoked actor permissions
+	if len(toDelete) > 0 {
+		err = uow.ActorPermission().BulkDelete(ctx, toDelete)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Update validity bounds of kept actor permissions
+	for _, ap := range existing {
+		if !slices.Contains(permissions, ap.Permission) || ap.Validity.Equal(validity[ap.Permission]) {
+			continue
+		}
+		ap.Validity = validity[ap.Permission]
+		_, err = uow.ActorPermission().Update(ctx, &ap)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Insert granted actor permissions
+	if len(toCreate) > 0 {
+		actorPerms := make([]rbac.ActorPermission, 0, len(toCreate))
+		for _, p := range toCreate {
+			actorPerms = append(actorPerms, rbac.ActorPermission{
+				Validity:   validity[p],
+				ActorType:  actorType,
+				ActorID:    input.ActorID,
+				Permission: p,
+			})
+		}
+		err = uow.ActorPermission().BulkCreate(ctx, actorPerms)
+		if err != nil {
+			return nil, errx.WrapWithTypeOnCodes(err, errx.T_Conflict, rbac.CodeActorPermissionConflict)
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of the actor on all instances
+	uc.pblcContainer.PermissionResolver().NotifyChange(ctx, rbac.PermissionChange{
+		ActorType: actorType,
+		ActorID:   input.ActorID,
+	})
+
+	return &Output{
+		ActorType:   input.ActorType,
+		ActorID:     input.ActorID,
+		Permissions: permissions,
+		Validity:    validity,
+	}, nil
+}
+
+// normalizeValidity normalizes permissions of the validity map
+// and checks that bounds are valid and set only for granted permissions.
+func normalizeValidity(
+	validity map[string]rbac.Validity,
+	permissions []string,
+	now time.Time,
+) (map[string]rbac.Validity, error) {
+	normalized := make(map[string]rbac.Validity, len(validity))
+	for p, v := range validity {
+		p = auth.NormalizePermission(p)
+		if !slices.Contains(permissions, p) {
+			return nil, errx.New(
+				"validity is set for a permission which is not granted",
+				errx.WithCode(rbac.CodeInvalidGrantValidity),
+				errx.WithDetails(errx.D{"permission": p}),
+			)
+		}
+
+		err := v.Validate(now)
+		if err != nil {
+			return nil, errx.Wrap(err, errx.WithDetails(errx.D{"permission": p}))
+		}
+		normalized[p] = v
+	}
+	return normalized, nil
+}
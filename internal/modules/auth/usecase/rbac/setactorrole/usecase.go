@@ -0,0 +1,224 @@
+package setactorrole
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"slices"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ActorType string  `json:"actor_type" validate:"required,oneof=user admin service_acc"`
+	ActorID   string  `json:"actor_id" validate:"required,uuid"`
+	RoleIDs   []int64 `json:"role_ids" validate:"dive,required"`
+
+	// Validity makes the listed roles time-bound by role ID, other roles are assigned without bounds.
+	Validity map[int64]rbac.Validity `json:"validity"`
+}
+
+type Output struct {
+	ActorType string     `json:"actor_type"`
+	ActorID   string     `json:"actor_id"`
+	Roles     []RoleInfo `json:"roles"`
+}
+
+type RoleInfo struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+
+	rbac.Validity
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "set-actor-role" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actorType := rbac.ActorType(input.ActorType)
+	roleIDs := slices.Compact(slices.Sorted(slices.Values(input.RoleIDs)))
+
+	// Validate the actor exists
+	err := uc.validateActorExists(ctx, actorType, input.ActorID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Validate all roles exist
+	roles := []rbac.Role{}
+	if len(roleIDs) > 0 {
+		roles, err = uc.domainContainer.RoleRepo().List(ctx, rbac.RoleFilter{IDs: roleIDs})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+	if len(roles) != len(roleIDs) {
+		return nil, errx.New(
+			"one or more roles do not exist",
+			errx.WithType(errx.T_NotFound),
+			errx.WithCode(rbac.CodeRoleNotFound),
+			errx.WithDetails(errx.D{"missing_role_ids": missingRoleIDs(roleIDs, roles)}),
+		)
+	}
+
+	// Validate all roles are meant for the actor type
+	for _, r := range roles {
+		if r.ActorType != actorType {
+			return nil, errx.New(
+				"role is meant for another actor type",
+				errx.WithType(errx.T_Validation),
+				errx.WithCode(rbac.CodeRoleActorTypeMismatch),
+				errx.WithDetails(errx.D{"role_id": r.ID, "role_actor_type": r.ActorType, "actor_type": actorType}),
+			)
+		}
+	}
+
+	// Validate validity bounds of time-bound roles
+	now := time.Now()
+	for roleID, v := range input.Validity {
+		if !slices.Contains(roleIDs, roleID) {
+			return nil, errx.New(
+				"validity is set for a role which is not assigned",
+				errx.WithType(errx.T_Validation),
+				errx.WithCode(rbac.CodeInvalidGrantValidity),
+				errx.WithDetails(errx.D{"role_id": roleID}),
+			)
+		}
+
+		err = v.Validate(now)
+		if err != nil {
+			return nil, errx.Wrap(err, errx.WithType(errx.T_Validation), errx.WithDetails(errx.D{"role_id": roleID}))
+		}
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Compute diff against existing actor roles
+	existing, err := uow.ActorRole().List(ctx, rbac.ActorRoleFilter{
+		ActorType: &actorType,
+		ActorID:   &input.ActorID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	toCreate, toDelete := rbac.Diff(existing, func(ar rbac.ActorRole) int64 { return ar.RoleID }, roleIDs)
+
+	// Delete revoked actor roles
+	if len(toDelete) > 0 {
+		err = uow.ActorRole().BulkDelete(ctx, toDelete)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Update validity bounds of kept actor roles
+	for _, ar := range existing {
+		if !slices.Contains(roleIDs, ar.RoleID) || ar.Validity.Equal(input.Validity[ar.RoleID]) {
+			continue
+		}
+		ar.Validity = input.Validity[ar.RoleID]
+		_, err = uow.ActorRole().Update(ctx, &ar)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Insert assigned actor roles
+	if len(toCreate) > 0 {
+		actorRoles := make([]rbac.ActorRole, 0, len(toCreate))
+		for _, roleID := range toCreate {
+			actorRoles = append(actorRoles, rbac.ActorRole{
+				Validity:  input.Validity[roleID],
+				ActorType: actorType,
+				ActorID:   input.ActorID,
+				RoleID:    roleID,
+			})
+		}
+		err = uow.ActorRole().BulkCreate(ctx, actorRoles)
+		if err != nil {
+			return nil, errx.WrapWithTypeOnCodes(err, errx.T_Conflict, rbac.CodeActorRoleConflict)
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of the actor on all instances
+	uc.pblcContainer.PermissionResolver().NotifyChange(ctx, rbac.PermissionChange{
+		ActorType: actorType,
+		ActorID:   input.ActorID,
+	})
+
+	roleInfos := make([]RoleInfo, 0, len(roles))
+	for _, r := range roles {
+		roleInfos = append(roleInfos, RoleInfo{ID: r.ID, Name: r.Name, Validity: input.Validity[r.ID]})
+	}
+
+	return &Output{
+		ActorType: input.ActorType,
+		ActorID:   input.ActorID,
+		Roles:     roleInfos,
+	}, nil
+}
+
+// validateActorExists returns the not found error of the actor's type if the actor doesn't exist.
+func (uc *usecase) validateActorExists(ctx context.Context, actorType rbac.ActorType, actorID string) error {
+	var err error
+	switch actorType {
+	case rbac.ActorTypeUser:
+		_, err = uc.domainContainer.UserRepo().Get(ctx, user.UserFilter{ID: &actorID})
+	case rbac.ActorTypeAdmin:
+		_, err = uc.domainContainer.AdminRepo().Get(ctx, user.AdminFilter{ID: &actorID})
+	case rbac.ActorTypeServiceAcc:
+		_, err = uc.domainContainer.ServiceAccountRepo().Get(ctx, user.ServiceAccountFilter{ID: &actorID})
+	default:
+		return errx.New(
+			"invalid actor type",
+			errx.WithType(errx.T_Validation),
+			errx.WithCode(rbac.CodeInvalidActorType),
+			errx.WithDetails(errx.D{"actor_type": actorType}),
+		)
+	}
+	return errx.WrapWithTypeOnCodes(
+		err,
+		errx.T_NotFound,
+		user.CodeUserNotFound,
+		user.CodeAdminNotFound,
+		user.CodeServiceAccountNotFound,
+	)
+}
+
+func missingRoleIDs(roleIDs []int64, roles []rbac.Role) []int64 {
+	missing := []int64{}
+	for _, id := range roleIDs {
+		if !slices.ContainsFunc(roles, func(r rbac.Role) bool { return r.ID == id }) {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
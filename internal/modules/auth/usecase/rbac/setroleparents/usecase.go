@@ -0,0 +1,182 @@
+package setroleparents
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"slices"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	RoleID        int64   `json:"role_id" validate:"required"`
+	ParentRoleIDs []int64 `json:"parent_role_ids" validate:"dive,required"`
+}
+
+type Output struct {
+	RoleID  int64      `json:"role_id"`
+	Parents []RoleInfo `json:"parents"`
+}
+
+type RoleInfo struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "set-role-parents" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	parentIDs := slices.Compact(slices.Sorted(slices.Values(input.ParentRoleIDs)))
+
+	// Validate role exists
+	role, err := uc.domainContainer.RoleRepo().Get(ctx, rbac.RoleFilter{ID: &input.RoleID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, rbac.CodeRoleNotFound)
+	}
+
+	// Validate all parent roles exist
+	parents := []rbac.Role{}
+	if len(parentIDs) > 0 {
+		parents, err = uc.domainContainer.RoleRepo().List(ctx, rbac.RoleFilter{IDs: parentIDs})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+	if len(parents) != len(parentIDs) {
+		return nil, errx.New(
+			"one or more parent roles do not exist",
+			errx.WithType(errx.T_NotFound),
+			errx.WithCode(rbac.CodeRoleNotFound),
+			errx.WithDetails(errx.D{"missing_role_ids": missingRoleIDs(parentIDs, parents)}),
+		)
+	}
+
+	// Validate parent roles are of the same actor type
+	for _, p := range parents {
+		if p.ActorType != role.ActorType {
+			return nil, errx.New(
+				"parent role must be of the same actor type as the role",
+				errx.WithType(errx.T_Validation),
+				errx.WithCode(rbac.CodeRoleParentInvalid),
+				errx.WithDetails(errx.D{
+					"role_actor_type":   role.ActorType,
+					"parent_role_id":    p.ID,
+					"parent_actor_type": p.ActorType,
+				}),
+			)
+		}
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Block concurrent hierarchy changes, two of them could create a cycle together
+	err = uow.RoleParent().LockHierarchy(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Detect cycles in the hierarchy with the requested parents
+	all, err := uow.RoleParent().List(ctx, rbac.RoleParentFilter{})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	err = checkCycles(all, input.RoleID, parentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute diff against existing role parents
+	existing := slices.DeleteFunc(all, func(rp rbac.RoleParent) bool { return rp.RoleID != input.RoleID })
+	toCreate, toDelete := rbac.Diff(existing, func(rp rbac.RoleParent) int64 { return rp.ParentRoleID }, parentIDs)
+
+	// Delete removed role parents
+	if len(toDelete) > 0 {
+		err = uow.RoleParent().BulkDelete(ctx, toDelete)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Insert added role parents
+	if len(toCreate) > 0 {
+		roleParents := make([]rbac.RoleParent, 0, len(toCreate))
+		for _, parentID := range toCreate {
+			roleParents = append(roleParents, rbac.RoleParent{RoleID: input.RoleID, ParentRoleID: parentID})
+		}
+		err = uow.RoleParent().BulkCreate(ctx, roleParents)
+		if err != nil {
+			return nil, errx.WrapWithTypeOnCodes(err, errx.T_Conflict, rbac.CodeRoleParentConflict)
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of actors holding the role or its descendant roles on all instances
+	uc.pblcContainer.PermissionResolver().NotifyChange(ctx, rbac.PermissionChange{RoleID: input.RoleID})
+
+	roleInfos := make([]RoleInfo, 0, len(parents))
+	for _, p := range parents {
+		roleInfos = append(roleInfos, RoleInfo{ID: p.ID, Name: p.Name})
+	}
+
+	return &Output{
+		RoleID:  input.RoleID,
+		Parents: roleInfos,
+	}, nil
+}
+
+// checkCycles returns an error if the role would become its own ancestor with the parents.
+func checkCycles(current []rbac.RoleParent, roleID int64, parentIDs []int64) error {
+	hierarchy := rbac.NewHierarchy(current)
+	delete(hierarchy, roleID)
+
+	for _, parentID := range parentIDs {
+		path := hierarchy.PathTo(parentID, roleID)
+		if path == nil {
+			continue
+		}
+		return errx.New(
+			"parent role would make the role inherit from itself",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(rbac.CodeRoleHierarchyCycle),
+			errx.WithDetails(errx.D{"cycle_role_ids": append([]int64{roleID}, path...)}),
+		)
+	}
+	return nil
+}
+
+func missingRoleIDs(roleIDs []int64, roles []rbac.Role) []int64 {
+	missing := []int64{}
+	for _, id := range roleIDs {
+		if !slices.ContainsFunc(roles, func(r rbac.Role) bool { return r.ID == id }) {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
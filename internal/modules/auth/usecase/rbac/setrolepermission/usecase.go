@@ -0,0 +1,102 @@
+package setrolepermission
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/modules/auth/pblc/permregistry"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	RoleID      int64    `json:"role_id" validate:"required"`
+	Permissions []string `json:"permissions" validate:"dive,required"`
+}
+
+type Output struct {
+	RoleID      int64    `json:"role_id"`
+	Permissions []string `json:"permissions"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "set-role-permission" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	permissions := permregistry.Normalize(input.Permissions)
+
+	// Validate permissions follow the grammar and are registered or match registered ones
+	err := uc.pblcContainer.PermissionRegistry().Validate(permissions)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Validate role exists
+	_, err = uc.domainContainer.RoleRepo().Get(ctx, rbac.RoleFilter{ID: &input.RoleID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, rbac.CodeRoleNotFound)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Compute diff against existing role permissions
+	existing, err := uow.RolePermission().List(ctx, rbac.RolePermissionFilter{RoleID: &input.RoleID})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	toCreate, toDelete := rbac.Diff(existing, func(rp rbac.RolePermission) string { return rp.Permission }, permissions)
+
+	// Delete revoked role permissions
+	if len(toDelete) > 0 {
+		err = uow.RolePermission().BulkDelete(ctx, toDelete)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+
+	// Insert granted role permissions
+	if len(toCreate) > 0 {
+		rolePerms := make([]rbac.RolePermission, 0, len(toCreate))
+		for _, p := range toCreate {
+			rolePerms = append(rolePerms, rbac.RolePermission{RoleID: input.RoleID, Permission: p})
+		}
+		err = uow.RolePermission().BulkCreate(ctx, rolePerms)
+		if err != nil {
+			return nil, errx.WrapWithTypeOnCodes(err, errx.T_Conflict, rbac.CodeRolePermissionConflict)
+		}
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of the role's actors on all instances
+	uc.pblcContainer.PermissionResolver().NotifyChange(ctx, rbac.PermissionChange{RoleID: input.RoleID})
+
+	return &Output{
+		RoleID:      input.RoleID,
+		Permissions: permissions,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package createrole
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ActorType string `json:"actor_type" validate:"required,oneof=user admin service_acc"`
+	Name      string `json:"name" validate:"required,min=2,max=100"`
+}
+
+type Output = rbac.Role
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "create-role" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Create role record, unique name is enforced by the database
+	role, err := uc.domainContainer.RoleRepo().Create(ctx, &rbac.Role{
+		ActorType: rbac.ActorType(input.ActorType),
+		Name:      input.Name,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, rbac.CodeRoleNameConflict)
+	}
+
+	return role, nil
+}
@@ -0,0 +1,54 @@
+package deleterole
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+type Output struct {
+	Success bool `json:"success"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "delete-role" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find role by ID
+	role, err := uc.domainContainer.RoleRepo().Get(ctx, rbac.RoleFilter{ID: &input.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, rbac.CodeRoleNotFound)
+	}
+
+	// Delete role, role permissions and actor roles are removed by ON DELETE CASCADE
+	err = uc.domainContainer.RoleRepo().Delete(ctx, role)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Drop cached permissions of the role's actors on all instances
+	uc.pblcContainer.PermissionResolver().NotifyChange(ctx, rbac.PermissionChange{RoleID: role.ID})
+
+	return &Output{Success: true}, nil
+}
@@ -0,0 +1,85 @@
+package getroles
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/pagination"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	pagination.Request
+
+	ActorType *string `query:"actor_type" validate:"omitempty,oneof=user admin service_acc"`
+}
+
+type Output = pagination.Response[Role]
+
+type Role struct {
+	rbac.Role
+
+	// ParentRoleIDs are IDs of the roles the role inherits permissions from.
+	ParentRoleIDs []int64 `json:"parent_role_ids"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "get-roles" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	input.Normalize()
+
+	filter := rbac.RoleFilter{
+		Limit:  input.Limit(),
+		Offset: input.Offset(),
+	}
+	if input.ActorType != nil {
+		actorType := rbac.ActorType(*input.ActorType)
+		filter.ActorType = &actorType
+	}
+
+	// Query roles with pagination
+	roles, total, err := uc.domainContainer.RoleRepo().ListWithCount(ctx, filter)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Query parents of the page's roles
+	roleIDs := make([]int64, 0, len(roles))
+	for _, r := range roles {
+		roleIDs = append(roleIDs, r.ID)
+	}
+	var parents []rbac.RoleParent
+	if len(roleIDs) > 0 {
+		parents, err = uc.domainContainer.RoleParentRepo().List(ctx, rbac.RoleParentFilter{RoleIDs: roleIDs})
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+	}
+	hierarchy := rbac.NewHierarchy(parents)
+
+	items := make([]Role, 0, len(roles))
+	for _, r := range roles {
+		parentIDs := hierarchy[r.ID]
+		if parentIDs == nil {
+			parentIDs = []int64{}
+		}
+		items = append(items, Role{Role: r, ParentRoleIDs: parentIDs})
+	}
+
+	resp := pagination.NewResponse(items, int64(total), input.Request)
+	return &resp, nil
+}
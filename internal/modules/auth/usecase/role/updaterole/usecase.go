@@ -0,0 +1,52 @@
+package updaterole
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ID   int64  `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+type Output = rbac.Role
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "update-role" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find role by ID
+	role, err := uc.domainContainer.RoleRepo().Get(ctx, rbac.RoleFilter{ID: &input.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, rbac.CodeRoleNotFound)
+	}
+
+	if role.Name == input.Name {
+		return role, nil
+	}
+
+	// Update role name, unique name is enforced by the database
+	role.Name = input.Name
+	role, err = uc.domainContainer.RoleRepo().Update(ctx, role)
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, rbac.CodeRoleNameConflict)
+	}
+
+	return role, nil
+}
@@ -0,0 +1,84 @@
+package createapikey
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+const day = 24 * time.Hour
+
+type Input struct {
+	ServiceAccountID string `json:"service_account_id" validate:"required,uuid"`
+	// ExpiresInDays is a lifetime of the key, the key never expires if omitted.
+	ExpiresInDays *int `json:"expires_in_days" validate:"omitempty,min=1,max=3650"`
+}
+
+type Output struct {
+	ID               int64      `json:"id"`
+	ServiceAccountID string     `json:"service_account_id"`
+	Key              string     `json:"key" mask:"true"`
+	Prefix           string     `json:"prefix"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "create-api-key" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Validate service account exists
+	_, err := uc.domainContainer.ServiceAccountRepo().Get(ctx, user.ServiceAccountFilter{
+		ID: &input.ServiceAccountID,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeServiceAccountNotFound)
+	}
+
+	// Generate the key
+	issued := uc.pblcContainer.TokenManager().IssueAPIKey()
+	var expiresAt *time.Time
+	if input.ExpiresInDays != nil {
+		t := time.Now().Add(time.Duration(*input.ExpiresInDays) * day)
+		expiresAt = &t
+	}
+
+	// Store hash of the key
+	key, err := uc.domainContainer.APIKeyRepo().Create(ctx, &apikey.APIKey{
+		ServiceAccountID: input.ServiceAccountID,
+		Prefix:           issued.Prefix,
+		KeyHash:          issued.Hash,
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		ID:               key.ID,
+		ServiceAccountID: key.ServiceAccountID,
+		Key:              issued.Key,
+		Prefix:           key.Prefix,
+		ExpiresAt:        key.ExpiresAt,
+		CreatedAt:        key.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package createserviceaccount
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+
+	"github.com/code19m/errx"
+	"github.com/google/uuid"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Name        string `json:"name" validate:"required,min=3,max=100"`
+	Description string `json:"description" validate:"max=500"`
+}
+
+type Output = user.ServiceAccount
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "create-service-account" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Create service account, unique name is enforced by the database
+	account, err := uc.domainContainer.ServiceAccountRepo().Create(ctx, &user.ServiceAccount{
+		ID:          uuid.NewString(),
+		Name:        input.Name,
+		Description: input.Description,
+		IsActive:    true,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, user.CodeServiceAccountNameConflict)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,56 @@
+package revokeapikey
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+type Output = apikey.APIKey
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "revoke-api-key" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find the key
+	key, err := uc.domainContainer.APIKeyRepo().Get(ctx, apikey.Filter{ID: &input.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, apikey.CodeAPIKeyNotFound)
+	}
+	if key.RevokedAt != nil {
+		return nil, errx.New(
+			"api key is already revoked",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(apikey.CodeAPIKeyAlreadyRevoked),
+		)
+	}
+
+	// Revoke the key
+	now := time.Now()
+	key.RevokedAt = &now
+	key, err = uc.domainContainer.APIKeyRepo().Update(ctx, key)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return key, nil
+}
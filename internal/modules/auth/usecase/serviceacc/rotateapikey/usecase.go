@@ -0,0 +1,113 @@
+package rotateapikey
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/apikey"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ID int64 `json:"id" validate:"required"`
+}
+
+type Output struct {
+	ID               int64      `json:"id"`
+	ServiceAccountID string     `json:"service_account_id"`
+	Key              string     `json:"key" mask:"true"`
+	Prefix           string     `json:"prefix"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	RotatedKeyID     int64      `json:"rotated_key_id"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "rotate-api-key" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Find the key being rotated
+	old, err := uc.domainContainer.APIKeyRepo().Get(ctx, apikey.Filter{ID: &input.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, apikey.CodeAPIKeyNotFound)
+	}
+	if old.RevokedAt != nil {
+		return nil, errx.New(
+			"api key is already revoked",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(apikey.CodeAPIKeyAlreadyRevoked),
+		)
+	}
+
+	// Generate the new key with the same lifetime as the old one
+	now := time.Now()
+	issued := uc.pblcContainer.TokenManager().IssueAPIKey()
+	var expiresAt *time.Time
+	if old.ExpiresAt != nil {
+		t := now.Add(old.ExpiresAt.Sub(old.CreatedAt))
+		expiresAt = &t
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Revoke the old key, a concurrent rotation or revocation of the key may have revoked it already
+	revoked, err := uow.APIKey().Revoke(ctx, old.ID, now)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	if !revoked {
+		return nil, errx.New(
+			"api key is already revoked",
+			errx.WithType(errx.T_Conflict),
+			errx.WithCode(apikey.CodeAPIKeyAlreadyRevoked),
+		)
+	}
+
+	// Store hash of the new key
+	key, err := uow.APIKey().Create(ctx, &apikey.APIKey{
+		ServiceAccountID: old.ServiceAccountID,
+		Prefix:           issued.Prefix,
+		KeyHash:          issued.Hash,
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		ID:               key.ID,
+		ServiceAccountID: key.ServiceAccountID,
+		Key:              issued.Key,
+		Prefix:           key.Prefix,
+		ExpiresAt:        key.ExpiresAt,
+		CreatedAt:        key.CreatedAt,
+		RotatedKeyID:     old.ID,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package cleanupexpiredsessions
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Config struct {
+	// Retention is how long sessions are kept after their refresh token expired. Default is 24 hours.
+	Retention time.Duration `yaml:"retention" default:"24h"`
+
+	// BatchSize is a maximum number of sessions deleted by a single statement. Default is 1000.
+	BatchSize int `yaml:"batch_size" validate:"min=1" default:"1000"`
+
+	// MaxBatches is a maximum number of batches deleted by a single run,
+	// remaining sessions are deleted by the next runs. Default is 100.
+	MaxBatches int `yaml:"max_batches" validate:"min=1" default:"100"`
+}
+
+type Payload struct{}
+
+type UseCase = ucdef.AsyncTask[*Payload]
+
+type usecase struct {
+	cfg             Config
+	domainContainer *domain.Container
+}
+
+func New(cfg Config, domainContainer *domain.Container) UseCase {
+	return &usecase{
+		cfg,
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "cleanup-expired-sessions" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Payload) error {
+	expiredBefore := time.Now().Add(-uc.cfg.Retention)
+
+	// Delete expired sessions in bounded batches, so a single statement doesn't lock the table for long
+	total := 0
+	for range uc.cfg.MaxBatches {
+		deleted, err := uc.domainContainer.SessionRepo().DeleteExpired(ctx, expiredBefore, uc.cfg.BatchSize)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		total += deleted
+		if deleted < uc.cfg.BatchSize {
+			break
+		}
+	}
+
+	logger.
+		WithContext(ctx).
+		With("deleted_count", total, "expired_before", expiredBefore).
+		Info("expired sessions cleaned up")
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package deleteuserallsessions
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	ActorType string `json:"actor_type" validate:"required,oneof=user admin service_acc"`
+	ActorID   string `json:"actor_id" validate:"required,uuid"`
+}
+
+type Output struct {
+	DeletedCount int `json:"deleted_count"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "delete-user-all-sessions" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Delete all sessions of the actor, including the rotated ones
+	sessions, err := uc.domainContainer.SessionRepo().DeleteByActor(ctx, input.ActorType, input.ActorID, nil)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	logger.
+		WithContext(ctx).
+		With("actor_type", input.ActorType, "actor_id", input.ActorID, "deleted_count", len(sessions)).
+		Warn("all sessions of actor deleted")
+
+	return &Output{DeletedCount: len(sessions)}, nil
+}
@@ -0,0 +1,97 @@
+package deleteusersession
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"go-enterprise-blueprint/internal/portal/auth"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/observability/logger"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	SessionID int64 `json:"session_id" validate:"required"`
+}
+
+type Output struct {
+	Success bool `json:"success"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "delete-user-session" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find session by ID
+	sess, err := uc.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ID: &input.SessionID,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, session.CodeSessionNotFound)
+	}
+
+	// Only superadmins can delete sessions of other actors
+	isOwn := sess.ActorType == actor.Type && sess.ActorID == actor.ID
+	if !isOwn {
+		var isSuperadmin bool
+		isSuperadmin, err = uc.pblcContainer.PermissionResolver().HasPermission(
+			ctx, rbac.ActorType(actor.Type), actor.ID, auth.PermissionSuperadmin,
+		)
+		if err != nil {
+			return nil, errx.Wrap(err)
+		}
+		if !isSuperadmin {
+			return nil, errx.New(
+				"session belongs to another actor",
+				errx.WithType(errx.T_Forbidden),
+				errx.WithCode(auth.CodePermissionDenied),
+			)
+		}
+	}
+
+	// Delete the session together with the rotated sessions of its login,
+	// so none of its refresh tokens can be used anymore
+	family, err := uc.domainContainer.SessionRepo().List(ctx, session.Filter{
+		ActorType: &sess.ActorType,
+		ActorID:   &sess.ActorID,
+		FamilyID:  &sess.FamilyID,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	err = uc.domainContainer.SessionRepo().BulkDelete(ctx, family)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	if !isOwn {
+		logger.
+			WithContext(ctx).
+			With("actor_type", sess.ActorType, "actor_id", sess.ActorID, "session_id", sess.ID).
+			Warn("session deleted by superadmin")
+	}
+
+	return &Output{Success: true}, nil
+}
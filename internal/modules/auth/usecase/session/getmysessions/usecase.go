@@ -0,0 +1,82 @@
+package getmysessions
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"slices"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct{}
+
+type Output struct {
+	Sessions []SessionInfo `json:"sessions"`
+}
+
+type SessionInfo struct {
+	ID         int64     `json:"id"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	IsCurrent  bool      `json:"is_current"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "get-my-sessions" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find not rotated sessions of the actor, rotated ones are replaced by the next session of their login
+	isRotated := false
+	sessions, err := uc.domainContainer.SessionRepo().List(ctx, session.Filter{
+		ActorType: &actor.Type,
+		ActorID:   &actor.ID,
+		IsRotated: &isRotated,
+	})
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Skip sessions which can't be refreshed anymore, ordered by last usage
+	now := time.Now()
+	infos := make([]SessionInfo, 0, len(sessions))
+	for _, sess := range sessions {
+		if now.After(sess.RefreshTokenExpiresAt) {
+			continue
+		}
+		infos = append(infos, SessionInfo{
+			ID:         sess.ID,
+			IPAddress:  sess.IPAddress,
+			UserAgent:  sess.UserAgent,
+			IsCurrent:  sess.ID == actor.SessionID,
+			LastUsedAt: sess.LastUsedAt,
+			CreatedAt:  sess.CreatedAt,
+		})
+	}
+	slices.SortFunc(infos, func(a, b SessionInfo) int {
+		return b.LastUsedAt.Compare(a.LastUsedAt)
+	})
+
+	return &Output{Sessions: infos}, nil
+}
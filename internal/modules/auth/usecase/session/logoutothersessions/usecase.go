@@ -0,0 +1,56 @@
+package logoutothersessions
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/portal/auth"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct{}
+
+type Output struct {
+	DeletedCount int `json:"deleted_count"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "logout-other-sessions" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find current session of the actor
+	current, err := uc.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ID:        &actor.SessionID,
+		ActorType: &actor.Type,
+		ActorID:   &actor.ID,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, session.CodeSessionNotFound)
+	}
+
+	// Delete sessions of the actor which don't belong to the current login
+	others, err := uc.domainContainer.SessionRepo().DeleteByActor(ctx, actor.Type, actor.ID, &current.FamilyID)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{DeletedCount: len(others)}, nil
+}
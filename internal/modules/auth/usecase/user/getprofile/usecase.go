@@ -0,0 +1,57 @@
+package getprofile
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct{}
+
+type Output struct {
+	ID           string     `json:"id"`
+	Email        string     `json:"email"`
+	FullName     string     `json:"full_name"`
+	LastActiveAt *time.Time `json:"last_active_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "get-profile" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find the calling user
+	u, err := uc.domainContainer.UserRepo().Get(ctx, user.UserFilter{ID: &actor.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeUserNotFound)
+	}
+
+	return &Output{
+		ID:           u.ID,
+		Email:        u.Email,
+		FullName:     u.FullName,
+		LastActiveAt: u.LastActiveAt,
+		CreatedAt:    u.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package updateprofile
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"strings"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	FullName string `json:"full_name" validate:"max=200"`
+}
+
+type Output struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	FullName  string    `json:"full_name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "update-profile" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find the calling user
+	u, err := uc.domainContainer.UserRepo().Get(ctx, user.UserFilter{ID: &actor.ID})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, user.CodeUserNotFound)
+	}
+
+	// Update profile fields
+	u.FullName = strings.TrimSpace(input.FullName)
+	u, err = uc.domainContainer.UserRepo().Update(ctx, u)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		ID:        u.ID,
+		Email:     u.Email,
+		FullName:  u.FullName,
+		UpdatedAt: u.UpdatedAt,
+	}, nil
+}
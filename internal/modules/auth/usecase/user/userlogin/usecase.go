@@ -0,0 +1,152 @@
+package userlogin
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"strings"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Email    string `json:"email" validate:"required,email,max=254"`
+	Password string `json:"password" validate:"required" mask:"true"`
+}
+
+type Output struct {
+	User    UserInfo    `json:"user"`
+	Session SessionInfo `json:"session"`
+}
+
+type UserInfo struct {
+	ID           string     `json:"id"`
+	Email        string     `json:"email"`
+	FullName     string     `json:"full_name"`
+	LastActiveAt *time.Time `json:"last_active_at"`
+}
+
+type SessionInfo struct {
+	AccessToken           string    `json:"access_token" mask:"true"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token" mask:"true"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "user-login" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+
+	// Check if email and client IP address are not locked out
+	err := uc.pblcContainer.LoginGuard().Check(ctx, rbac.ActorTypeUser, email)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find user by email
+	u, err := uc.domainContainer.UserRepo().Get(ctx, user.UserFilter{
+		Email: &email,
+	})
+	if errx.IsCodeIn(err, user.CodeUserNotFound) {
+		return nil, uc.invalidCredentials(ctx, email)
+	}
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Verify password hash
+	if !hasher.Compare(input.Password, u.PasswordHash) {
+		return nil, uc.invalidCredentials(ctx, email)
+	}
+
+	// Check if user is active
+	if !u.IsActive {
+		return nil, errx.New(
+			"user account is disabled",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeUserDisabled),
+		)
+	}
+
+	// Start UOW
+	uow, err := uc.domainContainer.UOWFactory().NewUOW(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+	defer uow.DiscardUnapplied()
+
+	// Issue access and refresh tokens and create session record with IP address and user agent
+	now := time.Now()
+	tokens, err := uc.pblcContainer.SessionManager().Start(ctx, uow, rbac.ActorTypeUser, u.ID, now)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Update user's last_active_at timestamp
+	u.LastActiveAt = &now
+	u, err = uow.User().Update(ctx, u)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Apply UOW
+	err = uow.ApplyChanges()
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Forget failed attempts of the email
+	err = uc.pblcContainer.LoginGuard().RegisterSuccess(ctx, rbac.ActorTypeUser, email)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		User: UserInfo{
+			ID:           u.ID,
+			Email:        u.Email,
+			FullName:     u.FullName,
+			LastActiveAt: u.LastActiveAt,
+		},
+		Session: SessionInfo{
+			AccessToken:           tokens.AccessToken,
+			AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+			RefreshToken:          tokens.RefreshToken,
+			RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+		},
+	}, nil
+}
+
+// invalidCredentials counts a failed attempt and returns an error which doesn't tell whether the email exists.
+func (uc *usecase) invalidCredentials(ctx context.Context, email string) error {
+	err := uc.pblcContainer.LoginGuard().RegisterFailure(ctx, rbac.ActorTypeUser, email)
+	if err != nil {
+		return errx.Wrap(err)
+	}
+
+	return errx.New(
+		"invalid email or password",
+		errx.WithType(errx.T_Authentication),
+		errx.WithCode(user.CodeInvalidCredentials),
+	)
+}
@@ -0,0 +1,56 @@
+package userlogout
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/session"
+	"go-enterprise-blueprint/internal/portal/auth"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct{}
+
+type Output struct {
+	Success bool `json:"success"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+}
+
+func New(domainContainer *domain.Container) UseCase {
+	return &usecase{
+		domainContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "user-logout" }
+
+func (uc *usecase) Execute(ctx context.Context, _ *Input) (*Output, error) {
+	actor, err := auth.GetActor(ctx)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Find current session of the user
+	sess, err := uc.domainContainer.SessionRepo().Get(ctx, session.Filter{
+		ID:        &actor.SessionID,
+		ActorType: &actor.Type,
+		ActorID:   &actor.ID,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_NotFound, session.CodeSessionNotFound)
+	}
+
+	// Delete the session, so its access and refresh tokens stop working
+	err = uc.domainContainer.SessionRepo().Delete(ctx, sess)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{Success: true}, nil
+}
@@ -0,0 +1,73 @@
+package userrefreshtoken
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/rbac"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	RefreshToken string `json:"refresh_token" validate:"required" mask:"true"`
+}
+
+type Output struct {
+	AccessToken           string    `json:"access_token" mask:"true"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token" mask:"true"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "user-refresh-token" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// Rotate the session of the refresh token
+	tokens, err := uc.pblcContainer.SessionManager().Refresh(ctx, rbac.ActorTypeUser, input.RefreshToken, uc.isActive)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	return &Output{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  tokens.AccessTokenExpiresAt,
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: tokens.RefreshTokenExpiresAt,
+	}, nil
+}
+
+// isActive checks if session's user is still active.
+func (uc *usecase) isActive(ctx context.Context, userID string) error {
+	u, err := uc.domainContainer.UserRepo().Get(ctx, user.UserFilter{
+		ID: &userID,
+	})
+	if err != nil {
+		return errx.Wrap(err)
+	}
+	if !u.IsActive {
+		return errx.New(
+			"user account is disabled",
+			errx.WithType(errx.T_Forbidden),
+			errx.WithCode(user.CodeUserDisabled),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package userregister
+
+import (
+	"context"
+	"go-enterprise-blueprint/internal/modules/auth/domain"
+	"go-enterprise-blueprint/internal/modules/auth/domain/user"
+	"go-enterprise-blueprint/internal/modules/auth/pblc"
+	"strings"
+	"time"
+
+	"github.com/code19m/errx"
+	"github.com/google/uuid"
+	"github.com/rise-and-shine/pkg/hasher"
+	"github.com/rise-and-shine/pkg/ucdef"
+)
+
+type Input struct {
+	Email    string `json:"email" validate:"required,email,max=254"`
+	Password string `json:"password" validate:"required" mask:"true"`
+	FullName string `json:"full_name" validate:"max=200"`
+}
+
+type Output struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	FullName  string    `json:"full_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UseCase = ucdef.UserAction[*Input, *Output]
+
+type usecase struct {
+	domainContainer *domain.Container
+	pblcContainer   *pblc.Container
+}
+
+func New(domainContainer *domain.Container, pblcContainer *pblc.Container) UseCase {
+	return &usecase{
+		domainContainer,
+		pblcContainer,
+	}
+}
+
+func (uc *usecase) OperationID() string { return "user-register" }
+
+func (uc *usecase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+
+	// Check if password satisfies the password policy
+	err := uc.pblcContainer.PasswordPolicy().Validate(input.Password, email)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Hash the password
+	passwordHash, err := hasher.Hash(input.Password)
+	if err != nil {
+		return nil, errx.Wrap(err)
+	}
+
+	// Create user, unique email is enforced by the database
+	u, err := uc.domainContainer.UserRepo().Create(ctx, &user.User{
+		ID:           uuid.NewString(),
+		Email:        email,
+		PasswordHash: passwordHash,
+		FullName:     strings.TrimSpace(input.FullName),
+		IsActive:     true,
+	})
+	if err != nil {
+		return nil, errx.WrapWithTypeOnCodes(err, errx.T_Validation, user.CodeUserEmailConflict)
+	}
+
+	return &Output{
+		ID:        u.ID,
+		Email:     u.Email,
+		FullName:  u.FullName,
+		CreatedAt: u.CreatedAt,
+	}, nil
+}
@@ -1,3 +1,27 @@
 package auth
 
-type Portal interface{}
+import "context"
+
+type Portal interface {
+	// Authenticate resolves the actor owning the given access token.
+	// Returns T_Authentication typed error if the token is invalid, expired or its session is revoked.
+	Authenticate(ctx context.Context, accessToken string) (*Actor, error)
+
+	// AuthenticateAPIKey resolves the service account owning the given API key.
+	// Returns T_Authentication typed error if the key is unknown, revoked, expired or its service account is disabled.
+	AuthenticateAPIKey(ctx context.Context, apiKey string) (*Actor, error)
+
+	// HasPermission reports whether the actor is granted the permission directly or through its roles.
+	// Granted wildcard patterns are matched with MatchPermission, the permission is normalized before matching.
+	// Actors with PermissionSuperadmin are granted every permission.
+	HasPermission(ctx context.Context, actorType, actorID, permission string) (bool, error)
+
+	// GetActorPermissions returns deduplicated effective permissions of the actor.
+	// They may contain wildcard patterns, use MatchPermission to check a permission against them.
+	GetActorPermissions(ctx context.Context, actorType, actorID string) ([]string, error)
+
+	// RegisterPermissions adds permissions declared by the module to the permission catalogue.
+	// Should be called once at module initialization. Only registered permissions can be granted.
+	// Permissions must be normalized, valid, without wildcards and namespaced with the module's name.
+	RegisterPermissions(module string, perms []PermissionDef) error
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/code19m/errx"
+	"github.com/rise-and-shine/pkg/meta"
+)
+
+type actorCtxKey struct{}
+
+// WithActor returns a copy of ctx carrying the authenticated actor.
+// Actor type and ID are also set as meta.ActorType and meta.ActorID values.
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	ctx = context.WithValue(ctx, actorCtxKey{}, actor)
+	ctx = context.WithValue(ctx, meta.ActorType, actor.Type)
+	ctx = context.WithValue(ctx, meta.ActorID, actor.ID)
+	return ctx
+}
+
+// GetActor returns the authenticated actor from ctx.
+// Returns T_Authentication typed error if the request is not authenticated.
+func GetActor(ctx context.Context) (*Actor, error) {
+	actor, ok := ctx.Value(actorCtxKey{}).(*Actor)
+	if !ok || actor == nil {
+		return nil, errx.New(
+			"authentication required",
+			errx.WithType(errx.T_Authentication),
+			errx.WithCode(CodeUnauthenticated),
+		)
+	}
+	return actor, nil
+}
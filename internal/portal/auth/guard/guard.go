@@ -0,0 +1,89 @@
+// Package guard provides fiber handlers which protect HTTP routes
+// by actor type and permissions of the authenticated actor.
+//
+// Guards are meant to be declared next to the route definitions:
+//
+//	g := guard.New(c.portalContainer.Auth)
+//	v1.Post("/create-role", g.RequirePermission(auth.PermissionSuperadmin), forward.ToUserAction(...))
+package guard
+
+import (
+	"fmt"
+	"go-enterprise-blueprint/internal/portal/auth"
+	"slices"
+
+	"github.com/code19m/errx"
+	"github.com/gofiber/fiber/v2"
+)
+
+type Guard struct {
+	authPortal func() auth.Portal
+}
+
+// New creates a Guard. The authPortal getter is called on each request,
+// since portals are set to the portal container after all modules are initialized.
+func New(authPortal func() auth.Portal) *Guard {
+	return &Guard{
+		authPortal,
+	}
+}
+
+// RequireAuth allows only authenticated actors.
+func (g *Guard) RequireAuth() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		_, err := auth.GetActor(c.UserContext())
+		if err != nil {
+			return errx.Wrap(err)
+		}
+		return c.Next()
+	}
+}
+
+// RequireActorType allows only authenticated actors of one of the given types.
+func (g *Guard) RequireActorType(actorTypes ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		actor, err := auth.GetActor(c.UserContext())
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		if !slices.Contains(actorTypes, actor.Type) {
+			return errx.New(
+				fmt.Sprintf("actor type %q is not allowed to access this route", actor.Type),
+				errx.WithType(errx.T_Forbidden),
+				errx.WithCode(auth.CodePermissionDenied),
+				errx.WithDetails(errx.D{"allowed_actor_types": actorTypes}),
+			)
+		}
+
+		return c.Next()
+	}
+}
+
+// RequirePermission allows only authenticated actors granted the permission.
+func (g *Guard) RequirePermission(permission string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+
+		actor, err := auth.GetActor(ctx)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		ok, err := g.authPortal().HasPermission(ctx, actor.Type, actor.ID, permission)
+		if err != nil {
+			return errx.Wrap(err)
+		}
+
+		if !ok {
+			return errx.New(
+				fmt.Sprintf("permission %q is required to access this route", permission),
+				errx.WithType(errx.T_Forbidden),
+				errx.WithCode(auth.CodePermissionDenied),
+				errx.WithDetails(errx.D{"required_permission": permission}),
+			)
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/code19m/errx"
+)
+
+const (
+	// PermissionSeparator separates segments of a permission, the first segment is a module namespace.
+	PermissionSeparator = ":"
+
+	// PermissionWildcard is a segment of a permission pattern which matches any segment.
+	// A trailing wildcard matches one or more remaining segments, e.g. "esign:*" matches "esign:document:sign".
+	PermissionWildcard = "*"
+)
+
+var (
+	repeatedSeparators = regexp.MustCompile(PermissionSeparator + "{2,}")
+	permissionSegment  = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+)
+
+// NormalizePermission returns the canonical form of the permission:
+// lower cased, without surrounding spaces and with repeated separators collapsed, e.g. "Docs::read" becomes "docs:read".
+func NormalizePermission(permission string) string {
+	permission = strings.ToLower(strings.TrimSpace(permission))
+	return repeatedSeparators.ReplaceAllString(permission, PermissionSeparator)
+}
+
+// ValidatePermission checks that the permission follows the grammar
+//
+//	permission = segment ":" segment { ":" segment }
+//	segment    = "*" | [a-z0-9][a-z0-9_-]*
+//
+// Permissions must be normalized with NormalizePermission before validation.
+// Returns CodeInvalidPermission coded error otherwise.
+func ValidatePermission(permission string) error {
+	segments := strings.Split(permission, PermissionSeparator)
+	valid := len(segments) >= 2
+	for _, s := range segments {
+		if s != PermissionWildcard && !permissionSegment.MatchString(s) {
+			valid = false
+		}
+	}
+
+	if !valid {
+		return errx.New(
+			"permission must look like <module>:<action> with optional * wildcards",
+			errx.WithCode(CodeInvalidPermission),
+			errx.WithDetails(errx.D{"permission": permission}),
+		)
+	}
+	return nil
+}
+
+// IsWildcardPermission reports whether the permission is a pattern with at least one wildcard segment.
+func IsWildcardPermission(permission string) bool {
+	for s := range strings.SplitSeq(permission, PermissionSeparator) {
+		if s == PermissionWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// PermissionNamespace returns the module namespace of the permission.
+func PermissionNamespace(permission string) string {
+	namespace, _, _ := strings.Cut(permission, PermissionSeparator)
+	return namespace
+}
+
+// MatchPermission reports whether the granted permission, which may be a wildcard pattern, covers the required one.
+// Both permissions must be normalized. PermissionSuperadmin is never matched by wildcards, it must be granted explicitly.
+func MatchPermission(granted, required string) bool {
+	if granted == required {
+		return true
+	}
+	if required == PermissionSuperadmin || !IsWildcardPermission(granted) {
+		return false
+	}
+
+	grantedSegments := strings.Split(granted, PermissionSeparator)
+	requiredSegments := strings.Split(required, PermissionSeparator)
+
+	for i, g := range grantedSegments {
+		if i >= len(requiredSegments) {
+			return false
+		}
+		if g == PermissionWildcard && i == len(grantedSegments)-1 {
+			return true
+		}
+		if g != PermissionWildcard && g != requiredSegments[i] {
+			return false
+		}
+	}
+	return len(grantedSegments) == len(requiredSegments)
+}
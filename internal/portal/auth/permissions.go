@@ -3,3 +3,10 @@ package auth
 const (
 	PermissionSuperadmin = "auth:superadmin"
 )
+
+// Permissions returns the catalogue of permissions declared by auth module.
+func Permissions() []PermissionDef {
+	return []PermissionDef{
+		{Name: PermissionSuperadmin, Description: "Full access to every operation of the system"},
+	}
+}
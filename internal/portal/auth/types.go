@@ -1 +1,36 @@
 package auth
+
+const (
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodeInvalidAccessToken = "INVALID_ACCESS_TOKEN"
+	CodeInvalidAPIKey      = "INVALID_API_KEY"
+	CodePermissionDenied   = "PERMISSION_DENIED"
+	CodeInvalidPermission  = "INVALID_PERMISSION"
+)
+
+const (
+	ActorTypeUser       = "user"
+	ActorTypeAdmin      = "admin"
+	ActorTypeServiceAcc = "service_acc"
+)
+
+// Actor is an authenticated caller of the system.
+type Actor struct {
+	// Type is one of: user, admin, service_acc.
+	Type string
+	ID   string
+
+	// SessionID is an ID of the session the actor is authenticated with.
+	// Zero for actors authenticated with an API key.
+	SessionID int64
+
+	// APIKeyID is an ID of the API key the service account is authenticated with.
+	// Zero for actors authenticated with an access token.
+	APIKeyID int64
+}
+
+// PermissionDef declares a permission of a module's permission catalogue.
+type PermissionDef struct {
+	Name        string
+	Description string
+}
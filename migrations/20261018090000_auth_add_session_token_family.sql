@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth.sessions ADD COLUMN family_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE auth.sessions ALTER COLUMN family_id DROP DEFAULT;
+
+ALTER TABLE auth.sessions ADD COLUMN generation INT NOT NULL DEFAULT 1;
+
+ALTER TABLE auth.sessions ADD COLUMN rotated_at TIMESTAMPTZ;
+
+CREATE INDEX idx_sessions_family_id ON auth.sessions (family_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS auth.idx_sessions_family_id;
+
+ALTER TABLE IF EXISTS auth.sessions DROP COLUMN IF EXISTS rotated_at;
+
+ALTER TABLE IF EXISTS auth.sessions DROP COLUMN IF EXISTS generation;
+
+ALTER TABLE IF EXISTS auth.sessions DROP COLUMN IF EXISTS family_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+DELETE FROM auth.role_permissions a
+USING auth.role_permissions b
+WHERE a.role_id = b.role_id
+  AND a.permission = b.permission
+  AND a.id > b.id;
+
+DELETE FROM auth.actor_roles a
+USING auth.actor_roles b
+WHERE a.actor_type = b.actor_type
+  AND a.actor_id = b.actor_id
+  AND a.role_id = b.role_id
+  AND a.id > b.id;
+
+DELETE FROM auth.actor_permissions a
+USING auth.actor_permissions b
+WHERE a.actor_type = b.actor_type
+  AND a.actor_id = b.actor_id
+  AND a.permission = b.permission
+  AND a.id > b.id;
+
+ALTER TABLE auth.role_permissions ADD CONSTRAINT uq_role_permissions_role_permission UNIQUE (role_id, permission);
+
+ALTER TABLE auth.actor_roles ADD CONSTRAINT uq_actor_roles_actor_role UNIQUE (actor_type, actor_id, role_id);
+
+ALTER TABLE auth.actor_permissions ADD CONSTRAINT uq_actor_permissions_actor_permission UNIQUE (actor_type, actor_id, permission);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS auth.actor_permissions
+DROP CONSTRAINT IF EXISTS uq_actor_permissions_actor_permission;
+
+ALTER TABLE IF EXISTS auth.actor_roles
+DROP CONSTRAINT IF EXISTS uq_actor_roles_actor_role;
+
+ALTER TABLE IF EXISTS auth.role_permissions
+DROP CONSTRAINT IF EXISTS uq_role_permissions_role_permission;
+-- +goose StatementEnd
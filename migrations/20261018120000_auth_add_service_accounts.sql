@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth.service_accounts (
+    id UUID PRIMARY KEY,
+    name VARCHAR NOT NULL UNIQUE,
+    description VARCHAR NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE auth.api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    service_account_id UUID NOT NULL,
+    prefix VARCHAR NOT NULL,
+    key_hash VARCHAR NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_keys_service_account_id ON auth.api_keys (service_account_id);
+
+ALTER TABLE auth.api_keys ADD CONSTRAINT fk_api_keys_service_account FOREIGN KEY (service_account_id) REFERENCES auth.service_accounts (id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS auth.api_keys
+DROP CONSTRAINT IF EXISTS fk_api_keys_service_account;
+
+DROP TABLE IF EXISTS auth.api_keys;
+
+DROP TABLE IF EXISTS auth.service_accounts;
+-- +goose StatementEnd
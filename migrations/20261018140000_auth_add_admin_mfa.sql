@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth.admins ADD COLUMN mfa_secret VARCHAR;
+
+ALTER TABLE auth.admins ADD COLUMN mfa_enabled_at TIMESTAMPTZ;
+
+ALTER TABLE auth.admins ADD COLUMN mfa_last_used_step BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE auth.login_challenges (
+    id BIGSERIAL PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    token_hash VARCHAR NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_login_challenges_admin_id ON auth.login_challenges (admin_id);
+
+CREATE TABLE auth.recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    code_hash VARCHAR NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_recovery_codes_admin_id ON auth.recovery_codes (admin_id);
+
+ALTER TABLE auth.login_challenges ADD CONSTRAINT fk_login_challenges_admin FOREIGN KEY (admin_id) REFERENCES auth.admins (id) ON DELETE CASCADE;
+
+ALTER TABLE auth.recovery_codes ADD CONSTRAINT fk_recovery_codes_admin FOREIGN KEY (admin_id) REFERENCES auth.admins (id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS auth.recovery_codes
+DROP CONSTRAINT IF EXISTS fk_recovery_codes_admin;
+
+ALTER TABLE IF EXISTS auth.login_challenges
+DROP CONSTRAINT IF EXISTS fk_login_challenges_admin;
+
+DROP TABLE IF EXISTS auth.recovery_codes;
+
+DROP TABLE IF EXISTS auth.login_challenges;
+
+ALTER TABLE IF EXISTS auth.admins DROP COLUMN IF EXISTS mfa_last_used_step;
+
+ALTER TABLE IF EXISTS auth.admins DROP COLUMN IF EXISTS mfa_enabled_at;
+
+ALTER TABLE IF EXISTS auth.admins DROP COLUMN IF EXISTS mfa_secret;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth.login_failures (
+    id BIGSERIAL PRIMARY KEY,
+    actor_type VARCHAR NOT NULL,
+    scope VARCHAR NOT NULL,
+    subject VARCHAR NOT NULL,
+    failed_attempts INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_login_failures_actor_type_scope_subject UNIQUE (actor_type, scope, subject)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS auth.login_failures;
+-- +goose StatementEnd
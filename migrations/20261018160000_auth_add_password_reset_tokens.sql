@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth.password_reset_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    token_hash VARCHAR NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_password_reset_tokens_admin_id ON auth.password_reset_tokens (admin_id);
+
+ALTER TABLE auth.password_reset_tokens ADD CONSTRAINT fk_password_reset_tokens_admin FOREIGN KEY (admin_id) REFERENCES auth.admins (id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE IF EXISTS auth.password_reset_tokens
+DROP CONSTRAINT IF EXISTS fk_password_reset_tokens_admin;
+
+DROP TABLE IF EXISTS auth.password_reset_tokens;
+-- +goose StatementEnd
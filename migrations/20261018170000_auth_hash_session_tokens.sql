@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Plain tokens can't be hashed without the hash secret, so existing sessions are invalidated
+-- and every actor has to log in again.
+DELETE FROM auth.sessions;
+
+DROP INDEX IF EXISTS auth.idx_sessions_access_token;
+
+DROP INDEX IF EXISTS auth.idx_sessions_refresh_token;
+
+ALTER TABLE auth.sessions RENAME COLUMN access_token TO access_token_hash;
+
+ALTER TABLE auth.sessions RENAME COLUMN refresh_token TO refresh_token_hash;
+
+CREATE UNIQUE INDEX idx_sessions_access_token_hash ON auth.sessions (access_token_hash);
+
+CREATE UNIQUE INDEX idx_sessions_refresh_token_hash ON auth.sessions (refresh_token_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM auth.sessions;
+
+DROP INDEX IF EXISTS auth.idx_sessions_access_token_hash;
+
+DROP INDEX IF EXISTS auth.idx_sessions_refresh_token_hash;
+
+ALTER TABLE auth.sessions RENAME COLUMN access_token_hash TO access_token;
+
+ALTER TABLE auth.sessions RENAME COLUMN refresh_token_hash TO refresh_token;
+
+CREATE INDEX idx_sessions_access_token ON auth.sessions (access_token);
+
+CREATE INDEX idx_sessions_refresh_token ON auth.sessions (refresh_token);
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_sessions_refresh_token_expires_at ON auth.sessions (refresh_token_expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS auth.idx_sessions_refresh_token_expires_at;
+-- +goose StatementEnd
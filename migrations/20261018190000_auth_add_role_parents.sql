@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth.role_parents (
+    id BIGSERIAL PRIMARY KEY,
+    role_id BIGINT NOT NULL,
+    parent_role_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_role_parents_role_parent UNIQUE (role_id, parent_role_id),
+    CONSTRAINT chk_role_parents_not_self CHECK (role_id <> parent_role_id)
+);
+
+CREATE INDEX idx_role_parents_parent_role_id ON auth.role_parents (parent_role_id);
+
+ALTER TABLE auth.role_parents ADD CONSTRAINT fk_role_parents_role FOREIGN KEY (role_id) REFERENCES auth.roles (id) ON DELETE CASCADE;
+
+ALTER TABLE auth.role_parents ADD CONSTRAINT fk_role_parents_parent_role FOREIGN KEY (parent_role_id) REFERENCES auth.roles (id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS auth.role_parents;
+-- +goose StatementEnd
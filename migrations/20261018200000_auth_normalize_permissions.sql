@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Normalization matches auth.NormalizePermission: lower cased, trimmed and with repeated ':' separators collapsed.
+-- Rows which become duplicates after normalization are dropped first to keep unique constraints satisfied.
+DELETE FROM auth.role_permissions a
+USING auth.role_permissions b
+WHERE a.role_id = b.role_id
+  AND regexp_replace(lower(btrim(a.permission)), ':{2,}', ':', 'g') = regexp_replace(lower(btrim(b.permission)), ':{2,}', ':', 'g')
+  AND a.id > b.id;
+
+UPDATE auth.role_permissions
+SET permission = regexp_replace(lower(btrim(permission)), ':{2,}', ':', 'g'),
+    updated_at = CURRENT_TIMESTAMP
+WHERE permission <> regexp_replace(lower(btrim(permission)), ':{2,}', ':', 'g');
+
+DELETE FROM auth.actor_permissions a
+USING auth.actor_permissions b
+WHERE a.actor_type = b.actor_type
+  AND a.actor_id = b.actor_id
+  AND regexp_replace(lower(btrim(a.permission)), ':{2,}', ':', 'g') = regexp_replace(lower(btrim(b.permission)), ':{2,}', ':', 'g')
+  AND a.id > b.id;
+
+UPDATE auth.actor_permissions
+SET permission = regexp_replace(lower(btrim(permission)), ':{2,}', ':', 'g'),
+    updated_at = CURRENT_TIMESTAMP
+WHERE permission <> regexp_replace(lower(btrim(permission)), ':{2,}', ':', 'g');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Original spelling of normalized permissions is not kept, nothing to revert.
+SELECT 1;
+-- +goose StatementEnd
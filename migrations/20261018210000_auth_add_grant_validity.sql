@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth.actor_permissions
+ADD COLUMN valid_from TIMESTAMPTZ,
+ADD COLUMN valid_until TIMESTAMPTZ,
+ADD CONSTRAINT chk_actor_permissions_validity CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until);
+
+ALTER TABLE auth.actor_roles
+ADD COLUMN valid_from TIMESTAMPTZ,
+ADD COLUMN valid_until TIMESTAMPTZ,
+ADD CONSTRAINT chk_actor_roles_validity CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until);
+
+CREATE INDEX idx_actor_permissions_valid_until ON auth.actor_permissions (valid_until) WHERE valid_until IS NOT NULL;
+
+CREATE INDEX idx_actor_roles_valid_until ON auth.actor_roles (valid_until) WHERE valid_until IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Time-bound grants are revoked, otherwise they would become permanent without their bounds
+DELETE FROM auth.actor_roles WHERE valid_from IS NOT NULL OR valid_until IS NOT NULL;
+
+DELETE FROM auth.actor_permissions WHERE valid_from IS NOT NULL OR valid_until IS NOT NULL;
+
+DROP INDEX IF EXISTS auth.idx_actor_roles_valid_until;
+
+DROP INDEX IF EXISTS auth.idx_actor_permissions_valid_until;
+
+ALTER TABLE IF EXISTS auth.actor_roles
+DROP CONSTRAINT IF EXISTS chk_actor_roles_validity,
+DROP COLUMN IF EXISTS valid_until,
+DROP COLUMN IF EXISTS valid_from;
+
+ALTER TABLE IF EXISTS auth.actor_permissions
+DROP CONSTRAINT IF EXISTS chk_actor_permissions_validity,
+DROP COLUMN IF EXISTS valid_until,
+DROP COLUMN IF EXISTS valid_from;
+-- +goose StatementEnd
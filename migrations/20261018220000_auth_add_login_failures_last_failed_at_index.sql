@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_login_failures_last_failed_at ON auth.login_failures (last_failed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS auth.idx_login_failures_last_failed_at;
+-- +goose StatementEnd
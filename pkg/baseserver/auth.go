@@ -0,0 +1,77 @@
+package baseserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/code19m/errx"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rise-and-shine/pkg/http/server"
+	"github.com/rise-and-shine/pkg/meta"
+)
+
+const (
+	codeInvalidAuthHeader   = "INVALID_AUTHORIZATION_HEADER"
+	codeAmbiguousCredential = "AMBIGUOUS_CREDENTIALS"
+
+	bearerPrefix = "Bearer "
+
+	headerAPIKey = "X-API-Key"
+)
+
+// AuthenticateFunc authenticates a request by its credential (bearer token or API key) and returns
+// a copy of ctx enriched with the calling actor (meta.ActorType and meta.ActorID must be set).
+type AuthenticateFunc func(ctx context.Context, credential string) (context.Context, error)
+
+// newAuthMW creates a middleware that authenticates requests carrying a bearer token
+// in Authorization header or an API key in X-API-Key header.
+//
+// Requests without credentials are passed through anonymously,
+// so it is up to route handlers to require an authenticated actor.
+// Requests carrying both credentials are rejected.
+// Actor type and ID are also stored in fiber locals to be used by logger and alerting middlewares.
+func newAuthMW(authenticate, authenticateAPIKey AuthenticateFunc) server.Middleware {
+	return server.Middleware{
+		Priority: 300,
+		Handler: func(c *fiber.Ctx) error {
+			header := c.Get(fiber.HeaderAuthorization)
+			apiKey := strings.TrimSpace(c.Get(headerAPIKey))
+
+			var (
+				ctx context.Context
+				err error
+			)
+			switch {
+			case header == "" && apiKey == "":
+				return c.Next()
+			case header != "" && apiKey != "":
+				return errx.New(
+					"only one of Authorization and X-API-Key headers must be provided",
+					errx.WithType(errx.T_Authentication),
+					errx.WithCode(codeAmbiguousCredential),
+				)
+			case apiKey != "":
+				ctx, err = authenticateAPIKey(c.UserContext(), apiKey)
+			default:
+				token, ok := strings.CutPrefix(header, bearerPrefix)
+				if !ok || strings.TrimSpace(token) == "" {
+					return errx.New(
+						"authorization header must be in format: Bearer <token>",
+						errx.WithType(errx.T_Authentication),
+						errx.WithCode(codeInvalidAuthHeader),
+					)
+				}
+				ctx, err = authenticate(c.UserContext(), strings.TrimSpace(token))
+			}
+			if err != nil {
+				return errx.Wrap(err)
+			}
+
+			c.SetUserContext(ctx)
+			c.Locals(meta.ActorType, meta.Find(ctx, meta.ActorType))
+			c.Locals(meta.ActorID, meta.Find(ctx, meta.ActorID))
+
+			return c.Next()
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package baseserver
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rise-and-shine/pkg/http/server"
+	"github.com/rise-and-shine/pkg/meta"
+)
+
+// newMetaInjectMW creates a middleware that injects client metadata (IP address, user agent)
+// into the request's user context, so use cases can read them via meta package.
+func newMetaInjectMW() server.Middleware {
+	return server.Middleware{
+		Priority: 700,
+		Handler: func(c *fiber.Ctx) error {
+			ctx := c.UserContext()
+			ctx = context.WithValue(ctx, meta.IPAddress, c.IP())
+			ctx = context.WithValue(ctx, meta.UserAgent, c.Get(fiber.HeaderUserAgent))
+
+			c.SetUserContext(ctx)
+
+			return c.Next()
+		},
+	}
+}
@@ -8,14 +8,18 @@ import (
 
 func New(
 	cfg server.Config,
+	authenticate AuthenticateFunc,
+	authenticateAPIKey AuthenticateFunc,
 ) *server.HTTPServer {
 	middlewares := []server.Middleware{
 		middleware.NewRecoveryMW(cfg.HideErrorDetails),
 		middleware.NewTracingMW(),
 		middleware.NewTimeoutMW(cfg.HandleTimeout),
+		newMetaInjectMW(),
 		middleware.NewAlertingMW(),
 		middleware.NewLoggerMW(cfg.HideErrorDetails),
 		middleware.NewErrorHandlerMW(cfg.HideErrorDetails),
+		newAuthMW(authenticate, authenticateAPIKey),
 	}
 
 	return server.NewHTTPServer(cfg, middlewares)
@@ -0,0 +1,161 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"go-enterprise-blueprint/pkg/totp"
+	"testing"
+	"time"
+)
+
+// rfcSeed is the SHA1 seed of RFC 6238 Appendix B.
+const rfcSeed = "12345678901234567890"
+
+func TestHOTPRFC6238Vectors(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		step := totp.Step(time.Unix(tt.unix, 0))
+		got := totp.HOTP([]byte(rfcSeed), uint64(step), 8)
+		if got != tt.want {
+			t.Errorf("HOTP at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte(rfcSeed))
+
+	// Codes are the last 6 digits of the 8 digit RFC vectors
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := totp.Code(secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code at %d: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("Code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1234567890, 0)
+	current := totp.Step(now)
+
+	tests := []struct {
+		name      string
+		offset    time.Duration
+		skew      int
+		wantOK    bool
+		wantDelta int64
+	}{
+		{"current step", 0, 1, true, 0},
+		{"previous step within skew", -totp.Period, 1, true, -1},
+		{"next step within skew", totp.Period, 1, true, 1},
+		{"previous step without skew", -totp.Period, 0, false, 0},
+		{"two steps behind with skew 1", -2 * totp.Period, 1, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := totp.Code(secret, now.Add(tt.offset))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			step, ok, err := totp.Validate(secret, code, now, tt.skew)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("Validate ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != current+tt.wantDelta {
+				t.Errorf("Validate step = %d, want %d", step, current+tt.wantDelta)
+			}
+		})
+	}
+}
+
+func TestValidateLastUsedStep(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1234567890, 0)
+
+	code, err := totp.Code(secret, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first use of the code succeeds and its step is remembered as the last used one
+	lastUsedStep, ok, err := totp.Validate(secret, code, now, 1)
+	if err != nil || !ok {
+		t.Fatalf("first Validate = %v, %v, want ok", ok, err)
+	}
+
+	// Reusing the code within the skew window matches the same step, which callers must reject
+	step, ok, err := totp.Validate(secret, code, now.Add(totp.Period), 1)
+	if err != nil || !ok {
+		t.Fatalf("second Validate = %v, %v, want ok", ok, err)
+	}
+	if step > lastUsedStep {
+		t.Errorf("reused code matched step %d after last used step %d, reuse would be accepted", step, lastUsedStep)
+	}
+
+	// The next code has a later step and is accepted
+	next, err := totp.Code(secret, now.Add(totp.Period))
+	if err != nil {
+		t.Fatal(err)
+	}
+	step, ok, err = totp.Validate(secret, next, now.Add(totp.Period), 1)
+	if err != nil || !ok {
+		t.Fatalf("next Validate = %v, %v, want ok", ok, err)
+	}
+	if step <= lastUsedStep {
+		t.Errorf("next code matched step %d, want after last used step %d", step, lastUsedStep)
+	}
+}
+
+func TestValidateRejectsMalformedCode(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, code := range []string{"", "12345", "1234567", "abcdef"} {
+		_, ok, err := totp.Validate(secret, code, time.Now(), 1)
+		if err != nil {
+			t.Fatalf("Validate(%q): %v", code, err)
+		}
+		if ok {
+			t.Errorf("Validate(%q) ok = true, want false", code)
+		}
+	}
+}